@@ -0,0 +1,145 @@
+package goenum
+
+import "strings"
+
+// maxFoldKey is the longest key lookupFold upper-cases on the stack
+const maxFoldKey = 64
+
+// SetCaseSensitive makes GetByName match names and aliases exactly instead of ignoring
+// case, skipping case folding on every lookup, and returns the EnumSet for chaining
+func (es *EnumSet[T]) SetCaseSensitive(enabled bool) *EnumSet[T] {
+	es.caseSensitive = enabled
+	es.aliasIndex = nil
+	for _, enum := range es.Values() {
+		es.indexAlias(enum)
+	}
+	return es
+}
+
+// aliasKey returns the key of alias in the alias index
+func (es *EnumSet[T]) aliasKey(alias string) string {
+	if es.caseSensitive {
+		return alias
+	}
+	return strings.ToUpper(alias)
+}
+
+// indexAlias adds the aliases of a registered enum to the alias index, keeping the enums
+// sharing an alias in registration order
+func (es *EnumSet[T]) indexAlias(enum T) {
+	aliases := enum.Aliases()
+	if len(aliases) == 0 {
+		return
+	}
+	if es.aliasIndex == nil {
+		es.aliasIndex = make(map[string][]T)
+	}
+	position := es.positions[enum.String()]
+	for _, alias := range aliases {
+		key := es.aliasKey(alias)
+		indexed := es.aliasIndex[key]
+		at := len(indexed)
+		for i, other := range indexed {
+			if other.String() == enum.String() {
+				at = -1
+				break
+			}
+			if at == len(indexed) && es.positions[other.String()] > position {
+				at = i
+			}
+		}
+		if at >= 0 {
+			es.aliasIndex[key] = append(indexed[:at:at], append([]T{enum}, indexed[at:]...)...)
+		}
+	}
+}
+
+// unindexAlias removes the aliases of an enum from the alias index
+func (es *EnumSet[T]) unindexAlias(enum T) {
+	for _, alias := range enum.Aliases() {
+		es.unindexAliasOf(enum, alias)
+	}
+}
+
+// unindexAliasOf removes a single alias of an enum from the alias index
+func (es *EnumSet[T]) unindexAliasOf(enum T, alias string) {
+	key := es.aliasKey(alias)
+	indexed := es.aliasIndex[key]
+	for i, other := range indexed {
+		if other.String() == enum.String() {
+			if len(indexed) == 1 {
+				delete(es.aliasIndex, key)
+			} else {
+				es.aliasIndex[key] = append(indexed[:i:i], indexed[i+1:]...)
+			}
+			return
+		}
+	}
+}
+
+// ownerIndexAliases implements enumOwner, indexing aliases added to a registered enum
+func (es *EnumSet[T]) ownerIndexAliases(name string) {
+	if enum, exists := es.values[name]; exists {
+		es.indexAlias(enum)
+	}
+}
+
+// lookupName finds the enum named name, ignoring case unless the set is case-sensitive
+func (es *EnumSet[T]) lookupName(name string) (T, bool) {
+	if es.caseSensitive {
+		enum, exists := es.values[name]
+		return enum, exists
+	}
+	return lookupFold(es.values, name)
+}
+
+// lookupAlias finds the first registered enum with the alias
+func (es *EnumSet[T]) lookupAlias(alias string) (T, bool) {
+	var indexed []T
+	if es.caseSensitive {
+		indexed = es.aliasIndex[alias]
+	} else {
+		indexed, _ = lookupFold(es.aliasIndex, alias)
+	}
+	if len(indexed) == 0 {
+		var zero T
+		return zero, false
+	}
+	return indexed[0], true
+}
+
+// matchesAlias reports whether enum has the alias, ignoring case unless the set is case-sensitive
+func (es *EnumSet[T]) matchesAlias(enum T, alias string) bool {
+	if !es.caseSensitive {
+		return enum.HasAlias(alias)
+	}
+	for _, a := range enum.Aliases() {
+		if a == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupFold looks up key in upper case in m, without allocating for short ASCII keys
+func lookupFold[V any](m map[string]V, key string) (V, bool) {
+	if len(key) > maxFoldKey {
+		value, exists := m[strings.ToUpper(key)]
+		return value, exists
+	}
+	var buf [maxFoldKey]byte
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c >= 0x80 {
+			value, exists := m[strings.ToUpper(key)]
+			return value, exists
+		}
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		buf[i] = c
+	}
+	// The compiler does not allocate for string conversions used as map keys
+	value, exists := m[string(buf[:len(key)])]
+	return value, exists
+}
@@ -0,0 +1,122 @@
+package goenum
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasIndex(t *testing.T) {
+	t.Run("aliases added after registration", func(t *testing.T) {
+		enum := NewEnumBase(1, "ACTIVE", "Active", "RUNNING")
+		set := NewEnumSet[*EnumBase]().Register(enum)
+		enum.WithDeprecatedAliases("LIVE")
+
+		found, exists := set.GetByName("live")
+		assert.True(t, exists, "WithDeprecatedAliases() should index new aliases")
+		assert.Equal(t, enum, found)
+	})
+
+	t.Run("unregistered aliases", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().Register(NewEnumBase(1, "ACTIVE", "Active", "RUNNING"))
+		set.Unregister("ACTIVE")
+		set.Register(NewEnumBase(2, "STARTED", "Started", "RUNNING"))
+
+		found, exists := set.GetByName("running")
+		assert.True(t, exists)
+		assert.Equal(t, "STARTED", found.String())
+	})
+
+	t.Run("ambiguous aliases", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]()
+		for i, name := range []string{"FIRST", "SECOND", "THIRD"} {
+			set.Register(NewEnumBase(i, name, name, "SHARED"))
+		}
+		for i := 0; i < 10; i++ {
+			found, _ := set.GetByName("shared")
+			assert.Equal(t, "FIRST", found.String(), "ambiguous aliases should resolve to the first registered enum")
+		}
+
+		set.Unregister("FIRST")
+		found, exists := set.GetByName("shared")
+		assert.True(t, exists)
+		assert.Equal(t, "SECOND", found.String(), "the next enum with the alias should take over")
+
+		set.SetCaseSensitive(true)
+		found, _ = set.GetByName("SHARED")
+		assert.Equal(t, "SECOND", found.String(), "rebuilding the index should keep registration order")
+	})
+
+	t.Run("aliases moved by the loader", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{AliasHandling: DuplicateOverride})
+		assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{
+			{Name: "OLD", Value: 1, Aliases: []string{"CURRENT"}},
+			{Name: "NEW", Value: 2, Aliases: []string{"CURRENT"}},
+		}))
+		found, _ := loader.GetEnumSet().GetByName("current")
+		assert.Equal(t, "NEW", found.String())
+	})
+
+	t.Run("non-ASCII and long names", func(t *testing.T) {
+		long := strings.Repeat("X", 100)
+		set := NewEnumSet[*EnumBase]().
+			Register(NewEnumBase(1, "ÉTÉ", "Summer", "SOMMER")).
+			Register(NewEnumBase(2, long, "Long", "L"+long))
+
+		_, exists := set.GetByName("été")
+		assert.True(t, exists)
+		_, exists = set.GetByName(strings.ToLower(long))
+		assert.True(t, exists)
+		_, exists = set.GetByName("l" + strings.ToLower(long))
+		assert.True(t, exists)
+	})
+
+	t.Run("case-sensitive sets", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().
+			Register(NewEnumBase(1, "Active", "Active", "running")).
+			SetCaseSensitive(true)
+
+		_, exists := set.GetByName("Active")
+		assert.True(t, exists)
+		_, exists = set.GetByName("running")
+		assert.True(t, exists)
+		_, exists = set.GetByName("ACTIVE")
+		assert.False(t, exists, "case-sensitive sets should match names exactly")
+		_, exists = set.GetByName("RUNNING")
+		assert.False(t, exists, "case-sensitive sets should match aliases exactly")
+
+		set.SetCaseSensitive(false)
+		_, exists = set.GetByName("Running")
+		assert.True(t, exists)
+	})
+
+	t.Run("allocation-free lookups", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).Register(TestEnumC)
+		for _, name := range []string{"A", "b", "ALPHA", "charlie", "missing"} {
+			allocs := testing.AllocsPerRun(100, func() { set.GetByName(name) })
+			assert.Zero(t, allocs, "GetByName(%q) should not allocate", name)
+		}
+		allocs := testing.AllocsPerRun(100, func() { set.GetByValue(2) })
+		assert.Zero(t, allocs, "GetByValue() should not allocate")
+		allocs = testing.AllocsPerRun(100, func() { _ = TestEnumA.String() })
+		assert.Zero(t, allocs, "String() should not allocate")
+	})
+}
+
+func BenchmarkGetByName(b *testing.B) {
+	set := NewEnumSet[*EnumBase]()
+	for i := 0; i < 64; i++ {
+		set.Register(NewEnumBase(i, fmt.Sprintf("STATUS_%d", i), "", fmt.Sprintf("ALIAS_%d", i)))
+	}
+
+	for _, name := range []string{"STATUS_42", "status_42", "alias_42", "missing"} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				set.GetByName(name)
+			}
+		})
+	}
+}
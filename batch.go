@@ -0,0 +1,22 @@
+package goenum
+
+import "fmt"
+
+// RegisterAll registers the enums only if all of them can be registered: every enum is
+// checked against the set and the enums before it as Register would check it, including
+// names and aliases colliding with other names and aliases under AliasCollisionError, and
+// the first failure is returned without changing the set. Validators run once per enum.
+func (es *EnumSet[T]) RegisterAll(enums ...T) error {
+	scratch := es.clone()
+	for _, enum := range enums {
+		if err := scratch.checkRegister(enum); err != nil {
+			return fmt.Errorf("enum %s: %w", enum.String(), err)
+		}
+		scratch.add(enum)
+	}
+
+	for _, enum := range enums {
+		es.applyRegister(enum, es)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterAll(t *testing.T) {
+	newSet := func() *EnumSet[*EnumBase] {
+		return NewEnumSet[*EnumBase]().
+			SetAliasCollisionPolicy(AliasCollisionError).
+			Register(NewEnumBase(1, "ACTIVE", "", "ENABLED"))
+	}
+
+	t.Run("registers the batch", func(t *testing.T) {
+		set := newSet()
+		pending, closed := NewEnumBase(2, "PENDING", "", "WAITING"), NewEnumBase(3, "CLOSED", "")
+		assert.NoError(t, set.RegisterAll(pending, closed))
+		assert.Len(t, set.Values(), 3)
+		enum, exists := set.GetByName("waiting")
+		assert.True(t, exists)
+		assert.Same(t, pending, enum)
+	})
+
+	t.Run("rejects the whole batch", func(t *testing.T) {
+		for name, batch := range map[string]struct {
+			enums []*EnumBase
+			err   string
+		}{
+			"duplicate name in set": {
+				[]*EnumBase{NewEnumBase(2, "PENDING", ""), NewEnumBase(3, "ACTIVE", "")},
+				"enum ACTIVE: duplicate enum name: ACTIVE",
+			},
+			"duplicate value in batch": {
+				[]*EnumBase{NewEnumBase(2, "PENDING", ""), NewEnumBase(2, "WAITING", "")},
+				"enum WAITING: duplicate enum value: 2",
+			},
+			"alias of another enum": {
+				[]*EnumBase{NewEnumBase(2, "PENDING", "", "enabled")},
+				"enum PENDING: alias enabled is an alias of ACTIVE",
+			},
+			"alias naming another enum": {
+				[]*EnumBase{NewEnumBase(2, "PENDING", "", "CLOSED"), NewEnumBase(3, "CLOSED", "")},
+				"enum CLOSED: name CLOSED is an alias of PENDING",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				set := newSet()
+				assert.EqualError(t, set.RegisterAll(batch.enums...), batch.err)
+				assert.Len(t, set.Values(), 1, "a failed batch should not register anything")
+				for _, enum := range batch.enums {
+					assert.Nil(t, enum.owner, "a failed batch should not bind enums")
+				}
+			})
+		}
+	})
+
+	t.Run("follows the alias collision policy", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().Register(NewEnumBase(1, "ACTIVE", "", "ENABLED"))
+		pending := NewEnumBase(2, "PENDING", "", "enabled")
+		assert.NoError(t, set.RegisterAll(pending), "colliding aliases are allowed by default, as with Register")
+		enum, _ := set.GetByName("ENABLED")
+		assert.Equal(t, "ACTIVE", enum.String(), "the first enum should keep the alias")
+	})
+
+	t.Run("runs validators", func(t *testing.T) {
+		set := newSet().AddIndex("value", func(e *EnumBase) interface{} { return e.Value() })
+		set.AddValidator(func(e *EnumBase) error {
+			if e.Description() == "" {
+				return assert.AnError
+			}
+			return nil
+		})
+		err := set.RegisterAll(NewEnumBase(2, "PENDING", "Waiting"), NewEnumBase(3, "CLOSED", ""))
+		assert.ErrorIs(t, err, assert.AnError)
+		_, exists := set.GetByIndex("value", 2)
+		assert.False(t, exists, "indexes should be untouched")
+	})
+
+	t.Run("runs validators once per enum", func(t *testing.T) {
+		set := newSet()
+		calls := map[string]int{}
+		set.AddValidator(func(e *EnumBase) error {
+			calls[e.String()]++
+			return nil
+		})
+		err := set.RegisterAll(NewEnumBase(2, "PENDING", ""), NewEnumBase(3, "CLOSED", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"PENDING": 1, "CLOSED": 1}, calls)
+		assert.True(t, set.ContainsName("CLOSED"), "RegisterAll() should register the batch")
+	})
+}
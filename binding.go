@@ -0,0 +1,38 @@
+package goenum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarshalText implements encoding.TextMarshaler, returning the enum name
+func (e *EnumBase) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, setting the enum name.
+// Use BindEnum to resolve the complete registered enum instead.
+func (e *EnumBase) UnmarshalText(text []byte) error {
+	if e == nil {
+		return fmt.Errorf("cannot unmarshal into nil EnumBase")
+	}
+	e.name = string(text)
+	return nil
+}
+
+// UnmarshalParam implements the parameter binding interface used by Echo and Gin
+func (e *EnumBase) UnmarshalParam(param string) error {
+	return e.UnmarshalText([]byte(param))
+}
+
+// BindEnum resolves raw against the set by name or alias and stores the result in target.
+// It is intended for UnmarshalText/UnmarshalParam implementations on enum types so that
+// request binding fails (and frameworks respond 400) for unregistered values.
+func BindEnum[T Enum](set *EnumSet[T], target *T, raw string) error {
+	enum, exists := set.GetByName(strings.TrimSpace(raw))
+	if !exists {
+		return newUnknownEnumError(raw, set)
+	}
+	*target = enum
+	return nil
+}
@@ -0,0 +1,69 @@
+package goenum
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// boundTestEnum resolves itself against TestEnumSet when bound from text
+type boundTestEnum struct {
+	TestEnum
+}
+
+func (b *boundTestEnum) UnmarshalText(text []byte) error {
+	return BindEnum(TestEnumSet, &b.TestEnum, string(text))
+}
+
+func TestEnumBinding(t *testing.T) {
+	t.Run("text marshaling", func(t *testing.T) {
+		data, err := TestEnumB.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "B", string(data), "MarshalText() should return the name")
+
+		base := &EnumBase{}
+		assert.NoError(t, base.UnmarshalText([]byte("C")))
+		assert.Equal(t, "C", base.String(), "UnmarshalText() should set the name")
+
+		assert.NoError(t, base.UnmarshalParam("A"))
+		assert.Equal(t, "A", base.String(), "UnmarshalParam() should set the name")
+
+		var nilBase *EnumBase
+		assert.Error(t, nilBase.UnmarshalText([]byte("A")), "UnmarshalText() should fail for nil EnumBase")
+	})
+
+	t.Run("enums as JSON map keys", func(t *testing.T) {
+		data, err := json.Marshal(map[*EnumBase]int{TestEnumA.EnumBase: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"A":1}`, string(data), "map keys should marshal as names")
+	})
+
+	t.Run("BindEnum() resolves registered enum", func(t *testing.T) {
+		var target TestEnum
+		assert.NoError(t, BindEnum(TestEnumSet, &target, " beta "))
+		assert.Equal(t, TestEnumB, target, "BindEnum() should store the registered enum")
+		assert.Equal(t, 2, target.Value(), "BindEnum() should restore the value")
+	})
+
+	t.Run("BindEnum() rejects unknown values", func(t *testing.T) {
+		var target TestEnum
+		err := BindEnum(TestEnumSet, &target, "Z")
+		assert.EqualError(t, err, `unknown enum "Z" (allowed: A, B, C)`)
+		assert.ErrorIs(t, err, ErrUnknownEnum)
+		assert.Nil(t, target.EnumBase, "BindEnum() should leave target untouched on error")
+	})
+
+	t.Run("TextUnmarshaler recipe", func(t *testing.T) {
+		form := url.Values{"status": {"charlie"}}
+		var bound boundTestEnum
+		assert.NoError(t, bound.UnmarshalText([]byte(form.Get("status"))))
+		assert.Equal(t, TestEnumC, bound.TestEnum)
+
+		var decoded struct {
+			Status boundTestEnum `json:"status"`
+		}
+		assert.Error(t, json.Unmarshal([]byte(`{"status":"nope"}`), &decoded), "binding should fail for unknown values")
+	})
+}
@@ -0,0 +1,117 @@
+package goenum
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EnumBuilder declares enums in order and assigns them sequential integer values, like iota
+type EnumBuilder[T Enum] struct {
+	start   int
+	step    int
+	entries []enumDeclaration
+}
+
+// enumDeclaration is an enum added to an EnumBuilder
+type enumDeclaration struct {
+	name        string
+	description string
+	aliases     []string
+}
+
+// NewEnumBuilder creates a new, empty EnumBuilder numbering enums 0, 1, 2, ...
+// T must be *EnumBase or a struct, or pointer to a struct, embedding *EnumBase.
+func NewEnumBuilder[T Enum]() *EnumBuilder[T] {
+	return &EnumBuilder[T]{step: 1}
+}
+
+// Start sets the value of the first enum and returns the EnumBuilder for chaining
+func (b *EnumBuilder[T]) Start(start int) *EnumBuilder[T] {
+	b.start = start
+	return b
+}
+
+// Step sets the difference between consecutive values and returns the EnumBuilder for chaining
+func (b *EnumBuilder[T]) Step(step int) *EnumBuilder[T] {
+	b.step = step
+	return b
+}
+
+// Add declares the next enum and returns the EnumBuilder for chaining
+func (b *EnumBuilder[T]) Add(name string) *EnumBuilder[T] {
+	return b.AddWith(name, "")
+}
+
+// AddWith declares the next enum with a description and aliases and returns the
+// EnumBuilder for chaining
+func (b *EnumBuilder[T]) AddWith(name string, description string, aliases ...string) *EnumBuilder[T] {
+	b.entries = append(b.entries, enumDeclaration{name: name, description: description, aliases: aliases})
+	return b
+}
+
+// Build creates the declared enums, valued start, start+step and so on, and registers
+// them in a new EnumSet. It returns the enums in the order they were added, or an error
+// if T cannot hold an *EnumBase or an enum cannot be registered, e.g. because of a
+// duplicate name.
+func (b *EnumBuilder[T]) Build() ([]T, *EnumSet[T], error) {
+	set := NewEnumSet[T]()
+	enums := make([]T, len(b.entries))
+	for i, entry := range b.entries {
+		enum, err := enumOf[T](NewEnumBase(b.start+i*b.step, entry.name, entry.description, entry.aliases...))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := set.TryRegister(enum); err != nil {
+			return nil, nil, fmt.Errorf("enum %s: %w", entry.name, err)
+		}
+		enums[i] = enum
+	}
+	return enums, set, nil
+}
+
+// enumOf wraps base in T, which is *EnumBase or a struct, or pointer to a struct,
+// embedding *EnumBase
+func enumOf[T Enum](base *EnumBase) (T, error) {
+	if enum, ok := any(base).(T); ok {
+		return enum, nil
+	}
+
+	var zero T
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	structType := t
+	if t.Kind() == reflect.Pointer {
+		structType = t.Elem()
+	}
+	if structType.Kind() == reflect.Struct {
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			if !field.Anonymous || field.Type != reflect.TypeOf(base) {
+				continue
+			}
+			v := reflect.New(structType)
+			v.Elem().Field(i).Set(reflect.ValueOf(base))
+			if t.Kind() != reflect.Pointer {
+				v = v.Elem()
+			}
+			if enum, ok := v.Interface().(T); ok {
+				return enum, nil
+			}
+		}
+	}
+	return zero, fmt.Errorf("cannot build %s enums: it must be *EnumBase or embed *EnumBase", t)
+}
+
+// NewStringEnum creates an enum whose value is its name, for string-coded enums
+func NewStringEnum(name string, description string, aliases ...string) *EnumBase {
+	return NewEnumBase(name, name, description, aliases...)
+}
+
+// NewStringEnumSet creates a set of string enums, each valued with its name.
+// It panics on duplicate names, unless in panic-free mode.
+func NewStringEnumSet(names ...string) *EnumSet[*EnumBase] {
+	set := NewEnumSet[*EnumBase]()
+	for _, name := range names {
+		set.Register(NewStringEnum(name, ""))
+	}
+	return set
+}
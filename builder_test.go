@@ -0,0 +1,76 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumBuilder(t *testing.T) {
+	t.Run("sequential values", func(t *testing.T) {
+		enums, set, err := NewEnumBuilder[*EnumBase]().Add("PENDING").Add("ACTIVE").Add("DELETED").Build()
+		assert.NoError(t, err)
+		for i, enum := range enums {
+			assert.Equal(t, i, enum.Value())
+		}
+		active, exists := set.GetByValue(1)
+		assert.True(t, exists)
+		assert.Same(t, enums[1], active)
+	})
+
+	t.Run("start, step and descriptions", func(t *testing.T) {
+		enums, set, err := NewEnumBuilder[*EnumBase]().Start(10).Step(5).
+			AddWith("LOW", "Low priority", "MINOR").
+			Add("MEDIUM").
+			Add("HIGH").
+			Build()
+		assert.NoError(t, err)
+		assert.Equal(t, 10, enums[0].Value())
+		assert.Equal(t, 20, enums[2].Value())
+		low, exists := set.GetByName("minor")
+		assert.True(t, exists)
+		assert.Equal(t, "Low priority", low.Description())
+	})
+
+	t.Run("embedding types", func(t *testing.T) {
+		enums, set, err := NewEnumBuilder[TestEnum]().Start(1).Add("A").Add("B").Build()
+		assert.NoError(t, err)
+		assert.Equal(t, "B", enums[1].String())
+		assert.Equal(t, 2, enums[1].Value())
+		assert.True(t, set.Contains(enums[0]))
+
+		type PointerEnum struct{ *EnumBase }
+		pointers, _, err := NewEnumBuilder[*PointerEnum]().Add("X").Build()
+		assert.NoError(t, err)
+		assert.Equal(t, "X", pointers[0].String())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := NewEnumBuilder[*EnumBase]().Add("A").Add("A").Build()
+		assert.EqualError(t, err, "enum A: duplicate enum name: A")
+
+		type WrappedEnum struct{ Enum }
+		_, _, err = NewEnumBuilder[WrappedEnum]().Add("A").Build()
+		assert.EqualError(t, err, "cannot build goenum.WrappedEnum enums: it must be *EnumBase or embed *EnumBase")
+	})
+}
+
+func TestStringEnums(t *testing.T) {
+	t.Run("NewStringEnum()", func(t *testing.T) {
+		enum := NewStringEnum("ACTIVE", "Active account", "ENABLED")
+		assert.Equal(t, "ACTIVE", enum.Value())
+		value, err := enum.StringValue()
+		assert.NoError(t, err)
+		assert.Equal(t, "ACTIVE", value)
+		assert.True(t, enum.HasAlias("enabled"))
+	})
+
+	t.Run("NewStringEnumSet()", func(t *testing.T) {
+		set := NewStringEnumSet("active", "inactive")
+		enum, exists := set.GetByValue("inactive")
+		assert.True(t, exists)
+		assert.Equal(t, "inactive", enum.String())
+		assert.ElementsMatch(t, []interface{}{"active", "inactive"}, MapTo(set, func(e *EnumBase) interface{} { return e.Value() }))
+		assert.Panics(t, func() { NewStringEnumSet("a", "a") })
+	})
+}
@@ -0,0 +1,116 @@
+package goenum
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CatalogFetcher loads a fresh enum set from a remote source, e.g. RedisLoader.Load
+// or KVCatalog.Load
+type CatalogFetcher func(ctx context.Context) (*EnumSet[Enum], error)
+
+// CachedCatalog caches the set returned by a CatalogFetcher so lookups never block on a
+// slow upstream once a set is loaded: fresh sets are served for TTL, then served stale
+// while a single background refresh runs, and kept as last-known-good when refreshes fail.
+type CachedCatalog struct {
+	// Fetch loads the set
+	Fetch CatalogFetcher
+	// TTL is how long a loaded set is fresh
+	TTL time.Duration
+	// MaxStale bounds how long after expiring a set may be served while revalidating;
+	// older sets are refetched synchronously, falling back to the stale set on failure.
+	// Zero serves stale sets indefinitely.
+	MaxStale time.Duration
+	// OnError, if not nil, receives refresh errors that were answered with a cached set
+	OnError func(error)
+
+	now        func() time.Time
+	entry      atomic.Pointer[cacheEntry]
+	fetchMu    sync.Mutex
+	refreshing atomic.Bool
+}
+
+// cacheEntry is a cached set and the time it was fetched
+type cacheEntry struct {
+	set       *EnumSet[Enum]
+	fetchedAt time.Time
+}
+
+// NewCachedCatalog creates a CachedCatalog for fetch with the given TTL
+func NewCachedCatalog(fetch CatalogFetcher, ttl time.Duration) *CachedCatalog {
+	return &CachedCatalog{Fetch: fetch, TTL: ttl}
+}
+
+// EnumSet returns the cached set, fetching it synchronously only when nothing is cached
+// yet or the cached set is older than TTL plus MaxStale
+func (c *CachedCatalog) EnumSet(ctx context.Context) (*EnumSet[Enum], error) {
+	entry := c.entry.Load()
+	if entry == nil {
+		return c.fetch(ctx)
+	}
+
+	age := c.clock().Sub(entry.fetchedAt)
+	switch {
+	case age < c.TTL:
+		return entry.set, nil
+	case c.MaxStale > 0 && age >= c.TTL+c.MaxStale:
+		set, err := c.fetch(ctx)
+		if err != nil {
+			c.report(err)
+			return entry.set, nil
+		}
+		return set, nil
+	default:
+		c.revalidate(ctx)
+		return entry.set, nil
+	}
+}
+
+// Refresh fetches the set synchronously, keeping the cached set on error
+func (c *CachedCatalog) Refresh(ctx context.Context) error {
+	_, err := c.fetch(ctx)
+	return err
+}
+
+// fetch loads and caches the set, letting only one fetch run at a time
+func (c *CachedCatalog) fetch(ctx context.Context) (*EnumSet[Enum], error) {
+	c.fetchMu.Lock()
+	defer c.fetchMu.Unlock()
+
+	set, err := c.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.entry.Store(&cacheEntry{set: set, fetchedAt: c.clock()})
+	return set, nil
+}
+
+// revalidate starts a background refresh unless one is already running
+func (c *CachedCatalog) revalidate(ctx context.Context) {
+	if !c.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer c.refreshing.Store(false)
+		if _, err := c.fetch(context.WithoutCancel(ctx)); err != nil {
+			c.report(err)
+		}
+	}()
+}
+
+// report passes a refresh error to OnError
+func (c *CachedCatalog) report(err error) {
+	if c.OnError != nil {
+		c.OnError(err)
+	}
+}
+
+// clock returns the current time
+func (c *CachedCatalog) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
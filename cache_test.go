@@ -0,0 +1,151 @@
+package goenum
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeFetcher returns a new set per call, or err when set
+type fakeFetcher struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+	block chan struct{}
+}
+
+func (f *fakeFetcher) fetch(context.Context) (*EnumSet[Enum], error) {
+	if f.block != nil {
+		<-f.block
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return NewEnumSet[Enum](), nil
+}
+
+func (f *fakeFetcher) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *fakeFetcher) fail(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// fakeClock is a manually advanced clock
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestCachedCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fresh set is served from cache", func(t *testing.T) {
+		fetcher := &fakeFetcher{}
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		cache := NewCachedCatalog(fetcher.fetch, time.Minute)
+		cache.now = clock.Now
+
+		first, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+		clock.Advance(30 * time.Second)
+		second, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+		assert.Same(t, first, second)
+		assert.Equal(t, 1, fetcher.count())
+	})
+
+	t.Run("first fetch error is returned", func(t *testing.T) {
+		fetcher := &fakeFetcher{err: errors.New("unavailable")}
+		cache := NewCachedCatalog(fetcher.fetch, time.Minute)
+		set, err := cache.EnumSet(ctx)
+		assert.Error(t, err)
+		assert.Nil(t, set)
+	})
+
+	t.Run("stale set is served while revalidating", func(t *testing.T) {
+		fetcher := &fakeFetcher{}
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		cache := NewCachedCatalog(fetcher.fetch, time.Minute)
+		cache.now = clock.Now
+		stale, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+
+		fetcher.block = make(chan struct{})
+		clock.Advance(2 * time.Minute)
+		for range 3 {
+			set, err := cache.EnumSet(ctx)
+			assert.NoError(t, err)
+			assert.Same(t, stale, set, "stale set should be served without blocking")
+		}
+		close(fetcher.block)
+		assert.Eventually(t, func() bool { return fetcher.count() == 2 }, time.Second, time.Millisecond,
+			"only one background refresh should run")
+		assert.Eventually(t, func() bool {
+			set, _ := cache.EnumSet(ctx)
+			return set != stale
+		}, time.Second, time.Millisecond)
+		assert.Equal(t, 2, fetcher.count())
+	})
+
+	t.Run("failed refresh keeps last-known-good", func(t *testing.T) {
+		fetcher := &fakeFetcher{}
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		errs := make(chan error, 1)
+		cache := NewCachedCatalog(fetcher.fetch, time.Minute)
+		cache.now = clock.Now
+		cache.OnError = func(err error) { errs <- err }
+		good, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+
+		refreshErr := errors.New("timeout")
+		fetcher.fail(refreshErr)
+		clock.Advance(2 * time.Minute)
+		set, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+		assert.Same(t, good, set)
+		assert.ErrorIs(t, <-errs, refreshErr)
+
+		assert.ErrorIs(t, cache.Refresh(ctx), refreshErr)
+		set, err = cache.EnumSet(ctx)
+		assert.NoError(t, err)
+		assert.Same(t, good, set)
+	})
+
+	t.Run("MaxStale refetches synchronously", func(t *testing.T) {
+		fetcher := &fakeFetcher{}
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		cache := NewCachedCatalog(fetcher.fetch, time.Minute)
+		cache.MaxStale = time.Minute
+		cache.now = clock.Now
+		old, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+
+		clock.Advance(3 * time.Minute)
+		set, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+		assert.NotSame(t, old, set)
+		assert.Equal(t, 2, fetcher.count())
+
+		var reported error
+		cache.OnError = func(err error) { reported = err }
+		fetcher.fail(errors.New("down"))
+		clock.Advance(3 * time.Minute)
+		fallback, err := cache.EnumSet(ctx)
+		assert.NoError(t, err)
+		assert.Same(t, set, fallback)
+		assert.Error(t, reported)
+	})
+}
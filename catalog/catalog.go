@@ -0,0 +1,56 @@
+// Package catalog serves goenum registries as a shared reference-data service and
+// materializes remote catalogs into local EnumSets.
+//
+// The API is described by catalog.proto. The package ships no generated code: Server and
+// Client work with the Service interface, so stubs generated into the application only
+// need thin adapters converting messages with EncodeValue and DecodeValue.
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// ErrSetNotFound is returned for names not present in the registry
+var ErrSetNotFound = errors.New("enum set not found")
+
+// Set is a named enum set with its content hash, mirroring the EnumSet message
+type Set struct {
+	Name string
+	// Hash is the EnumSet.Hash digest of the names, values and aliases of the set
+	Hash  string
+	Enums []goenum.EnumDefinition
+}
+
+// Service is the catalog API described by catalog.proto
+type Service interface {
+	// List returns every registered set, sorted by name
+	List(ctx context.Context) ([]Set, error)
+	// Get returns one set by name
+	Get(ctx context.Context, name string) (Set, error)
+	// Watch calls send with the set whenever its hash differs from the last one sent,
+	// starting with the current set unless it matches hash, until ctx is done or send fails
+	Watch(ctx context.Context, name, hash string, send func(Set) error) error
+}
+
+// EncodeValue encodes an enum value for the value_json field
+func EncodeValue(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	return string(data), err
+}
+
+// DecodeValue decodes a value_json field, converting whole numbers to int
+func DecodeValue(valueJSON string) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(valueJSON), &value); err != nil {
+		return nil, err
+	}
+	// Convert float64 to int if necessary
+	if f, ok := value.(float64); ok && f == float64(int(f)) {
+		return int(f), nil
+	}
+	return value, nil
+}
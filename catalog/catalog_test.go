@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStatusSet(extra ...*goenum.EnumBase) *goenum.EnumSet[*goenum.EnumBase] {
+	set := goenum.NewEnumSet[*goenum.EnumBase]().
+		Register(goenum.NewEnumBase(1, "ACTIVE", "Active", "RUNNING").
+			WithGroup("open").
+			WithDisplayName("Active now").
+			WithTags("live").
+			WithMeta("order", 1)).
+		Register(goenum.NewEnumBase("p", "PENDING", "Pending"))
+	for _, enum := range extra {
+		set.Register(enum)
+	}
+	return set
+}
+
+func TestServer(t *testing.T) {
+	goenum.RegisterSet("CatalogStatus", newStatusSet())
+	t.Cleanup(func() { goenum.UnregisterSet("CatalogStatus") })
+	server := NewServer()
+
+	t.Run("Get()", func(t *testing.T) {
+		set, err := server.Get(context.Background(), "CatalogStatus")
+		assert.NoError(t, err)
+		assert.Equal(t, "CatalogStatus", set.Name)
+		assert.Equal(t, newStatusSet().Hash(), set.Hash, "Get() should reuse EnumSet.Hash")
+		assert.Equal(t, []goenum.EnumDefinition{
+			{
+				Name: "ACTIVE", Value: 1, Description: "Active", Aliases: []string{"RUNNING"}, Group: "open",
+				DisplayName: "Active now", Tags: []string{"live"}, Meta: map[string]interface{}{"order": 1},
+			},
+			{Name: "PENDING", Value: "p", Description: "Pending"},
+		}, set.Enums)
+
+		_, err = server.Get(context.Background(), "Missing")
+		assert.True(t, errors.Is(err, ErrSetNotFound), "unknown sets should return ErrSetNotFound")
+		assert.EqualError(t, err, "enum set not found: Missing")
+	})
+
+	t.Run("List()", func(t *testing.T) {
+		sets, err := server.List(context.Background())
+		assert.NoError(t, err)
+		names := make([]string, 0, len(sets))
+		for _, set := range sets {
+			names = append(names, set.Name)
+		}
+		assert.Contains(t, names, "CatalogStatus")
+	})
+}
+
+func TestClient(t *testing.T) {
+	goenum.RegisterSet("CatalogSync", newStatusSet())
+	t.Cleanup(func() { goenum.UnregisterSet("CatalogSync") })
+	client := NewClient(NewServer())
+
+	t.Run("Materialize()", func(t *testing.T) {
+		set, err := client.Materialize(context.Background(), "CatalogSync")
+		assert.NoError(t, err)
+		enum, exists := set.GetByName("RUNNING")
+		assert.True(t, exists, "aliases should be materialized")
+		assert.Equal(t, 1, enum.Value())
+		assert.Equal(t, []string{"open"}, set.Groups(), "groups should be materialized")
+		assert.Equal(t, "Active now", goenum.DefinitionOf(enum).DisplayName, "display names should be materialized")
+		assert.Equal(t, []string{"live"}, goenum.DefinitionOf(enum).Tags, "tags should be materialized")
+		assert.Equal(t, map[string]interface{}{"order": 1}, goenum.DefinitionOf(enum).Meta, "metadata should be materialized")
+
+		_, err = client.Materialize(context.Background(), "Missing")
+		assert.ErrorIs(t, err, ErrSetNotFound)
+	})
+
+	t.Run("Sync()", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		updates := make(chan *goenum.EnumSet[goenum.Enum], 4)
+		done := make(chan error)
+		go func() {
+			done <- client.Sync(ctx, "CatalogSync", func(set *goenum.EnumSet[goenum.Enum]) { updates <- set })
+		}()
+
+		first := <-updates
+		assert.ElementsMatch(t, []string{"ACTIVE", "PENDING"}, first.Names(), "Sync() should start with the current set")
+
+		goenum.UnregisterSet("CatalogSync")
+		goenum.RegisterSet("CatalogSync", newStatusSet(goenum.NewEnumBase(2, "DELETED", "Deleted")))
+		second := <-updates
+		assert.ElementsMatch(t, []string{"ACTIVE", "PENDING", "DELETED"}, second.Names(), "Sync() should deliver changes")
+
+		registered, _ := goenum.LookupSetOf[*goenum.EnumBase]("CatalogSync")
+		registered.Register(goenum.NewEnumBase(3, "ARCHIVED", "Archived"))
+		goenum.NotifyRegistryChanged()
+		third := <-updates
+		assert.ElementsMatch(t, []string{"ACTIVE", "PENDING", "DELETED", "ARCHIVED"}, third.Names(),
+			"Sync() should deliver in-place changes once notified")
+
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+		assert.Empty(t, updates, "unchanged sets should not be sent again")
+	})
+
+	t.Run("Build() rejects invalid sets", func(t *testing.T) {
+		_, err := Build(Set{Name: "Bad", Enums: []goenum.EnumDefinition{{Name: "A", Value: 1}, {Name: "A", Value: 2}}})
+		assert.EqualError(t, err, "invalid enum set Bad: duplicate enum name: A")
+	})
+}
+
+func TestValueEncoding(t *testing.T) {
+	for _, value := range []interface{}{1, "p", true, 2.5} {
+		encoded, err := EncodeValue(value)
+		assert.NoError(t, err)
+		decoded, err := DecodeValue(encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, value, decoded, "value %v should round-trip", value)
+	}
+	_, err := DecodeValue("{")
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// Client materializes remote sets into local EnumSets
+type Client struct {
+	Service Service
+}
+
+// NewClient creates a Client for a Service, typically a gRPC client adapter
+func NewClient(service Service) *Client {
+	return &Client{Service: service}
+}
+
+// Materialize fetches a set and builds a local EnumSet from it
+func (c *Client) Materialize(ctx context.Context, name string) (*goenum.EnumSet[goenum.Enum], error) {
+	set, err := c.Service.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return Build(set)
+}
+
+// Sync watches a set and calls apply with a freshly built EnumSet on every change,
+// until ctx is done. Store the set atomically in apply to share it between goroutines.
+func (c *Client) Sync(ctx context.Context, name string, apply func(*goenum.EnumSet[goenum.Enum])) error {
+	return c.Service.Watch(ctx, name, "", func(set Set) error {
+		built, err := Build(set)
+		if err != nil {
+			return err
+		}
+		apply(built)
+		return nil
+	})
+}
+
+// Build creates an EnumSet from the definitions of a set
+func Build(set Set) (*goenum.EnumSet[goenum.Enum], error) {
+	result := goenum.NewEnumSet[goenum.Enum]()
+	for _, def := range set.Enums {
+		value := def.Value
+		// Convert float64 to int if necessary
+		if f, ok := value.(float64); ok && f == float64(int(f)) {
+			value = int(f)
+		}
+		enum := goenum.NewEnumBase(value, def.Name, def.Description, def.Aliases...).
+			WithGroup(def.Group).
+			WithDisplayName(def.DisplayName).
+			WithTags(def.Tags...)
+		for key, value := range def.Meta {
+			enum.WithMeta(key, value)
+		}
+		if err := result.TryRegister(enum); err != nil {
+			return nil, fmt.Errorf("invalid enum set %s: %w", set.Name, err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// Server implements Service over the sets in the goenum global registry
+type Server struct{}
+
+// NewServer creates a Server for the global registry
+func NewServer() *Server {
+	return &Server{}
+}
+
+// List implements Service
+func (s *Server) List(ctx context.Context) ([]Set, error) {
+	names := goenum.SetNames()
+	sets := make([]Set, 0, len(names))
+	for _, name := range names {
+		if set, exists := goenum.LookupSet(name); exists {
+			sets = append(sets, snapshot(name, set))
+		}
+	}
+	return sets, nil
+}
+
+// Get implements Service
+func (s *Server) Get(ctx context.Context, name string) (Set, error) {
+	set, exists := goenum.LookupSet(name)
+	if !exists {
+		return Set{}, fmt.Errorf("%w: %s", ErrSetNotFound, name)
+	}
+	return snapshot(name, set), nil
+}
+
+// Watch implements Service, waking on goenum.RegistryChanged rather than polling. Sets
+// changed in place after registration are sent once goenum.NotifyRegistryChanged is called.
+// Once watching has started, a set that is briefly unregistered (e.g. while being replaced)
+// is waited for rather than ending the watch.
+func (s *Server) Watch(ctx context.Context, name, hash string, send func(Set) error) error {
+	started := false
+	for {
+		changed := goenum.RegistryChanged()
+		current, err := s.Get(ctx, name)
+		switch {
+		case err != nil && !(started && errors.Is(err, ErrSetNotFound)):
+			return err
+		case err == nil && current.Hash != hash:
+			if err := send(current); err != nil {
+				return err
+			}
+			hash = current.Hash
+		}
+		started = true
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// snapshot captures the definitions of a set, in registration order
+func snapshot(name string, set goenum.AnyEnumSet) Set {
+	names := set.Names()
+	definitions := make([]goenum.EnumDefinition, 0, len(names))
+	for _, enumName := range names {
+		if enum, exists := set.EnumByName(enumName); exists {
+			definitions = append(definitions, goenum.DefinitionOf(enum))
+		}
+	}
+	return Set{Name: name, Hash: hashSet(set, definitions), Enums: definitions}
+}
+
+// hashSet returns the EnumSet.Hash digest of a set, building an EnumSet from its
+// definitions for sets that do not provide one
+func hashSet(set goenum.AnyEnumSet, definitions []goenum.EnumDefinition) string {
+	if hashed, ok := set.(interface{ Hash() string }); ok {
+		return hashed.Hash()
+	}
+	built, err := Build(Set{Enums: definitions})
+	if err != nil {
+		return ""
+	}
+	return built.Hash()
+}
@@ -0,0 +1,51 @@
+package goenum
+
+// Clone returns an independent copy of the enum: its aliases, tags, metadata, value range
+// and JSON configuration are copied, so changing the copy, e.g. with SetJSONConfig or
+// WithMeta, never affects the original. Metadata values themselves are not copied. The copy
+// keeps the set the original was first registered in for its JSON configuration fallback
+// and strict unmarshaling, but is not held by it.
+func (e *EnumBase) Clone() *EnumBase {
+	if e == nil {
+		return nil
+	}
+	clone := *e
+	clone.held = false
+	clone.aliases = cloneStrings(e.aliases)
+	clone.tags = cloneStrings(e.tags)
+	clone.deprecated = cloneStrings(e.deprecated)
+	if e.meta != nil {
+		clone.meta = make(map[string]interface{}, len(e.meta))
+		for key, value := range e.meta {
+			clone.meta[key] = value
+		}
+	}
+	if e.valueRange != nil {
+		valueRange := *e.valueRange
+		clone.valueRange = &valueRange
+	}
+	if e.jsonConfig != nil {
+		config := *e.jsonConfig
+		clone.jsonConfig = &config
+	}
+	return &clone
+}
+
+// Clone returns an independent copy of the composite enum with a cloned EnumBase, the
+// same flags and the same registry
+func (e *CompositeEnumBase) Clone() *CompositeEnumBase {
+	if e == nil {
+		return nil
+	}
+	clone := *e
+	clone.EnumBase = e.EnumBase.Clone()
+	return &clone
+}
+
+// cloneStrings copies s, keeping nil as nil
+func cloneStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(s)), s...)
+}
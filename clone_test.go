@@ -0,0 +1,50 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClone(t *testing.T) {
+	t.Run("EnumBase", func(t *testing.T) {
+		original := NewEnumBase(1, "ACTIVE", "Active", "on").WithMeta("tier", "gold").WithTags("live")
+		original.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatName})
+
+		clone := original.Clone()
+		assert.Equal(t, original.Aliases(), clone.Aliases())
+		assert.Equal(t, original.Metadata(), clone.Metadata())
+
+		clone.WithMeta("tier", "silver").WithTags("beta")
+		clone.aliases[0] = "enabled"
+		clone.GetJSONConfig().Format = JSONFormatValue
+
+		tier, _ := original.Meta("tier")
+		assert.Equal(t, "gold", tier, "the original metadata should not change")
+		assert.Equal(t, []string{"live"}, original.Tags())
+		assert.Equal(t, []string{"on"}, original.Aliases())
+
+		data, err := json.Marshal(original)
+		assert.NoError(t, err)
+		assert.Equal(t, `"ACTIVE"`, string(data))
+		data, err = json.Marshal(clone)
+		assert.NoError(t, err)
+		assert.Equal(t, `1`, string(data))
+
+		assert.Nil(t, (*EnumBase)(nil).Clone())
+	})
+
+	t.Run("CompositeEnumBase", func(t *testing.T) {
+		original := NewCompositeEnumBase(1, "READ", "Read access")
+		clone := original.Clone()
+		assert.True(t, clone.Equal(original))
+		assert.NotSame(t, original.EnumBase, clone.EnumBase)
+
+		clone.WithMeta("scope", "files")
+		_, exists := original.Meta("scope")
+		assert.False(t, exists)
+
+		assert.Nil(t, (*CompositeEnumBase)(nil).Clone())
+	})
+}
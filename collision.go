@@ -0,0 +1,89 @@
+package goenum
+
+import "fmt"
+
+// AliasCollisionPolicy defines how registration treats a name or alias that resolves to
+// another enum, which GetByName would silently shadow
+type AliasCollisionPolicy int
+
+const (
+	// AliasCollisionAllow registers colliding enums silently (default)
+	AliasCollisionAllow AliasCollisionPolicy = iota
+	// AliasCollisionWarn registers colliding enums and reports every collision to the
+	// hook installed with OnAliasCollision
+	AliasCollisionWarn
+	// AliasCollisionError rejects colliding enums with an *AliasCollision error
+	AliasCollisionError
+)
+
+// AliasCollision reports a name or alias of a registered enum that resolves to another
+// enum of the set
+type AliasCollision struct {
+	// Enum is the name of the enum being registered
+	Enum string
+	// Alias is the colliding alias of Enum, or empty when Enum's name is the alias of Other
+	Alias string
+	// Other is the name of the enum already registered
+	Other string
+	// OtherName reports whether Alias is the name of Other rather than one of its aliases
+	OtherName bool
+}
+
+// Error implements the error interface
+func (c *AliasCollision) Error() string {
+	switch {
+	case c.Alias == "":
+		return fmt.Sprintf("name %s is an alias of %s", c.Enum, c.Other)
+	case c.OtherName:
+		return fmt.Sprintf("alias %s is the name of %s", c.Alias, c.Other)
+	}
+	return fmt.Sprintf("alias %s is an alias of %s", c.Alias, c.Other)
+}
+
+// SetAliasCollisionPolicy sets how registration treats names and aliases resolving to
+// other enums and returns the EnumSet for chaining
+func (es *EnumSet[T]) SetAliasCollisionPolicy(policy AliasCollisionPolicy) *EnumSet[T] {
+	es.aliasPolicy = policy
+	return es
+}
+
+// OnAliasCollision installs the hook called for every collision registered under
+// AliasCollisionWarn and returns the EnumSet for chaining
+func (es *EnumSet[T]) OnAliasCollision(hook func(collision AliasCollision)) *EnumSet[T] {
+	es.aliasCollisionHook = hook
+	return es
+}
+
+// aliasCollisions returns the names and aliases of enum resolving to other enums of the set
+func (es *EnumSet[T]) aliasCollisions(enum T) []AliasCollision {
+	var collisions []AliasCollision
+	if other, exists := es.lookupAlias(enum.String()); exists {
+		collisions = append(collisions, AliasCollision{Enum: enum.String(), Other: other.String()})
+	}
+	for _, alias := range enum.Aliases() {
+		if other, exists := es.lookupName(alias); exists {
+			collisions = append(collisions, AliasCollision{Enum: enum.String(), Alias: alias, Other: other.String(), OtherName: true})
+		} else if other, exists := es.lookupAlias(alias); exists {
+			collisions = append(collisions, AliasCollision{Enum: enum.String(), Alias: alias, Other: other.String()})
+		}
+	}
+	return collisions
+}
+
+// checkAliases returns the first name or alias of enum resolving to another enum of the set
+func (es *EnumSet[T]) checkAliases(enum T) error {
+	if collisions := es.aliasCollisions(enum); len(collisions) > 0 {
+		return &collisions[0]
+	}
+	return nil
+}
+
+// reportAliasCollisions passes the collisions of a newly registered enum to the hook
+func (es *EnumSet[T]) reportAliasCollisions(enum T, collisions []AliasCollision) {
+	if es.aliasCollisionHook == nil {
+		return
+	}
+	for _, collision := range collisions {
+		es.aliasCollisionHook(collision)
+	}
+}
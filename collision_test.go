@@ -0,0 +1,60 @@
+package goenum
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasCollisions(t *testing.T) {
+	newSet := func(policy AliasCollisionPolicy) *EnumSet[*EnumBase] {
+		return NewEnumSet[*EnumBase]().
+			SetAliasCollisionPolicy(policy).
+			Register(NewEnumBase(1, "ACTIVE", "", "ENABLED"))
+	}
+
+	t.Run("allow by default", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().Register(NewEnumBase(1, "ACTIVE", ""))
+		assert.NoError(t, set.TryRegister(NewEnumBase(2, "LIVE", "", "active")))
+		enum, _ := set.GetByName("active")
+		assert.Equal(t, "ACTIVE", enum.String(), "the exact name should shadow the alias")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		set := newSet(AliasCollisionError)
+		err := set.TryRegister(NewEnumBase(2, "LIVE", "", "active"))
+		var collision *AliasCollision
+		assert.True(t, errors.As(err, &collision))
+		assert.Equal(t, AliasCollision{Enum: "LIVE", Alias: "active", Other: "ACTIVE", OtherName: true}, *collision)
+		assert.EqualError(t, err, "alias active is the name of ACTIVE")
+
+		assert.EqualError(t, set.TryRegister(NewEnumBase(2, "ENABLED", "")), "name ENABLED is an alias of ACTIVE")
+		assert.EqualError(t, set.TryRegister(NewEnumBase(2, "ON", "", "enabled")), "alias enabled is an alias of ACTIVE")
+		assert.Len(t, set.Values(), 1)
+		assert.NoError(t, set.TryRegister(NewEnumBase(2, "PAUSED", "", "PAUSED", "HOLD")), "an alias equal to its own name should not collide")
+	})
+
+	t.Run("error with case-sensitive sets", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().SetCaseSensitive(true).SetAliasCollisionPolicy(AliasCollisionError).
+			Register(NewEnumBase(1, "ACTIVE", ""))
+		assert.NoError(t, set.TryRegister(NewEnumBase(2, "LIVE", "", "active")))
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		var reported []AliasCollision
+		set := newSet(AliasCollisionWarn).OnAliasCollision(func(collision AliasCollision) {
+			reported = append(reported, collision)
+		})
+		assert.NoError(t, set.TryRegister(NewEnumBase(2, "ENABLED", "", "ACTIVE", "LIVE")))
+		assert.Equal(t, []AliasCollision{
+			{Enum: "ENABLED", Other: "ACTIVE"},
+			{Enum: "ENABLED", Alias: "ACTIVE", Other: "ACTIVE", OtherName: true},
+		}, reported)
+		assert.Len(t, set.Values(), 2)
+
+		reported = nil
+		assert.Error(t, set.TryRegister(NewEnumBase(2, "OTHER", "", "ACTIVE")), "failed registrations should not be reported")
+		assert.Empty(t, reported)
+	})
+}
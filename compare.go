@@ -0,0 +1,88 @@
+package goenum
+
+// CompareBy selects what Equals compares enums of two sets by
+type CompareBy int
+
+const (
+	// CompareByName matches enums by name only
+	CompareByName CompareBy = iota
+	// CompareByNameAndValue matches enums by name and value
+	CompareByNameAndValue
+	// CompareDeep matches enums by every field of their definitions: name, value,
+	// description, aliases, group, display name, tags and metadata
+	CompareDeep
+)
+
+// IsSubsetOf reports whether every enum in the set is in other with the same name and value
+func (es *EnumSet[T]) IsSubsetOf(other AnyEnumSet) bool {
+	return es.containedIn(other, CompareByNameAndValue)
+}
+
+// IsSupersetOf reports whether every enum in other is in the set with the same name and value
+func (es *EnumSet[T]) IsSupersetOf(other AnyEnumSet) bool {
+	if other == nil {
+		return true
+	}
+	for _, name := range other.Names() {
+		enum, exists := other.EnumByName(name)
+		if exists && !sameEnum(es, enum, CompareByNameAndValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals reports whether the set and other hold the same enums, compared by compareBy
+func (es *EnumSet[T]) Equals(other AnyEnumSet, compareBy CompareBy) bool {
+	if other == nil {
+		return len(es.values) == 0
+	}
+	return len(other.Names()) == len(es.values) && es.containedIn(other, compareBy)
+}
+
+// containedIn reports whether every enum in the set is in other, compared by compareBy
+func (es *EnumSet[T]) containedIn(other AnyEnumSet, compareBy CompareBy) bool {
+	if other == nil {
+		return len(es.values) == 0
+	}
+	for _, enum := range es.values {
+		if !sameEnum(other, enum, compareBy) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameEnum reports whether set holds an enum named like enum that matches it by compareBy
+func sameEnum(set AnyEnumSet, enum Enum, compareBy CompareBy) bool {
+	found, exists := set.EnumByName(enum.String())
+	if !exists || found.String() != enum.String() {
+		return false
+	}
+	switch compareBy {
+	case CompareByName:
+		return true
+	case CompareByNameAndValue:
+		return equalValues(found.Value(), enum.Value())
+	}
+
+	a, b := definitionOf(enum), definitionOf(found)
+	if len(changedFields(a, b)) > 0 || len(a.Tags) != len(b.Tags) || len(a.Meta) != len(b.Meta) {
+		return false
+	}
+	tags := make(map[string]bool, len(b.Tags))
+	for _, tag := range b.Tags {
+		tags[tag] = true
+	}
+	for _, tag := range a.Tags {
+		if !tags[tag] {
+			return false
+		}
+	}
+	for key, value := range a.Meta {
+		if other, exists := b.Meta[key]; !exists || !equalMeta(value, other) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetComparisons(t *testing.T) {
+	newSet := func(enums ...*EnumBase) *EnumSet[*EnumBase] {
+		set := NewEnumSet[*EnumBase]()
+		for _, enum := range enums {
+			set.Register(enum)
+		}
+		return set
+	}
+	base := newSet(NewEnumBase(1, "ACTIVE", "Active"), NewEnumBase(2, "CLOSED", "Closed"))
+	extended := newSet(NewEnumBase(1, "ACTIVE", "Active"), NewEnumBase(2, "CLOSED", "Closed"), NewEnumBase(3, "PAUSED", "Paused"))
+	renumbered := newSet(NewEnumBase(10, "ACTIVE", "Active"), NewEnumBase(20, "CLOSED", "Closed"))
+	described := newSet(NewEnumBase(1, "ACTIVE", "Active now").WithTags("live"), NewEnumBase(2, "CLOSED", "Closed"))
+
+	t.Run("IsSubsetOf()", func(t *testing.T) {
+		assert.True(t, base.IsSubsetOf(extended))
+		assert.True(t, base.IsSubsetOf(base))
+		assert.False(t, extended.IsSubsetOf(base))
+		assert.False(t, base.IsSubsetOf(renumbered), "values should match")
+		assert.True(t, NewEnumSet[*EnumBase]().IsSubsetOf(nil))
+	})
+
+	t.Run("IsSupersetOf()", func(t *testing.T) {
+		assert.True(t, extended.IsSupersetOf(base))
+		assert.False(t, base.IsSupersetOf(extended))
+		assert.False(t, base.IsSupersetOf(renumbered))
+		assert.True(t, base.IsSupersetOf(nil))
+	})
+
+	t.Run("Equals()", func(t *testing.T) {
+		assert.True(t, base.Equals(renumbered, CompareByName))
+		assert.False(t, base.Equals(renumbered, CompareByNameAndValue))
+		assert.True(t, base.Equals(described, CompareByNameAndValue))
+		assert.False(t, base.Equals(described, CompareDeep))
+		assert.True(t, described.Equals(newSet(NewEnumBase(1, "ACTIVE", "Active now").WithTags("live"), NewEnumBase(2, "CLOSED", "Closed")), CompareDeep))
+		assert.False(t, base.Equals(extended, CompareByName))
+		assert.False(t, base.Equals(nil, CompareByName))
+	})
+}
@@ -0,0 +1,94 @@
+package goenum
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConsulSource reads a catalog from a Consul KV key, watching it with blocking queries
+type ConsulSource struct {
+	// Address is the Consul HTTP address, e.g. "http://127.0.0.1:8500"
+	Address string
+	// Key is the KV key holding the catalog
+	Key string
+	// Token is an optional ACL token
+	Token string
+	// WaitTime bounds each blocking query; zero means five minutes
+	WaitTime time.Duration
+	// Client performs the requests; nil uses http.DefaultClient
+	Client *http.Client
+}
+
+// Get implements KVSource
+func (s *ConsulSource) Get(ctx context.Context) ([]byte, uint64, error) {
+	return s.query(ctx, nil)
+}
+
+// Wait implements KVSource, repeating blocking queries until the key's index changes
+func (s *ConsulSource) Wait(ctx context.Context, revision uint64) ([]byte, uint64, error) {
+	wait := s.WaitTime
+	if wait == 0 {
+		wait = 5 * time.Minute
+	}
+	for {
+		params := url.Values{}
+		params.Set("index", strconv.FormatUint(revision, 10))
+		params.Set("wait", wait.String())
+		data, index, err := s.query(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		// The index may also go backwards, e.g. after a snapshot restore
+		if index != revision {
+			return data, index, nil
+		}
+	}
+}
+
+// query reads the raw value of the key and its modify index
+func (s *ConsulSource) query(ctx context.Context, params url.Values) ([]byte, uint64, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("raw", "")
+	endpoint := strings.TrimSuffix(s.Address, "/") + "/v1/kv/" + strings.TrimPrefix(s.Key, "/") + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if s.Token != "" {
+		req.Header.Set("X-Consul-Token", s.Token)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("consul request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read consul response: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, 0, fmt.Errorf("consul key %s not found", s.Key)
+	case resp.StatusCode != http.StatusOK:
+		return nil, 0, fmt.Errorf("consul returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	index, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid consul index %q", resp.Header.Get("X-Consul-Index"))
+	}
+	return body, index, nil
+}
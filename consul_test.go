@@ -0,0 +1,96 @@
+package goenum
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeConsul serves one KV key with blocking queries
+type fakeConsul struct {
+	mu      sync.Mutex
+	value   string
+	index   uint64
+	changed chan struct{}
+}
+
+func (f *fakeConsul) put(value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.value = value
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/kv/enums/status" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Header.Get("X-Consul-Token") != "secret" {
+		http.Error(w, "ACL not found", http.StatusForbidden)
+		return
+	}
+	f.mu.Lock()
+	changed := f.changed
+	index := f.index
+	f.mu.Unlock()
+
+	if requested := r.URL.Query().Get("index"); requested == strconv.FormatUint(index, 10) {
+		select {
+		case <-changed:
+		case <-time.After(20 * time.Millisecond): // shortened wait; returns the same index
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(f.index, 10))
+	w.Write([]byte(f.value))
+}
+
+func TestConsulSource(t *testing.T) {
+	consul := &fakeConsul{value: `[{"name": "A", "value": 1}]`, index: 10, changed: make(chan struct{})}
+	server := httptest.NewServer(consul)
+	defer server.Close()
+	source := &ConsulSource{Address: server.URL + "/", Key: "enums/status", Token: "secret"}
+
+	t.Run("Get()", func(t *testing.T) {
+		data, index, err := source.Get(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"name": "A", "value": 1}]`, string(data))
+		assert.Equal(t, uint64(10), index)
+	})
+
+	t.Run("Wait() blocks until the index changes", func(t *testing.T) {
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			consul.put(`[{"name": "B", "value": 2}]`)
+		}()
+		data, index, err := source.Wait(context.Background(), 10)
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"name": "B", "value": 2}]`, string(data), "Wait() should survive wait timeouts")
+		assert.Equal(t, uint64(11), index)
+	})
+
+	t.Run("KVCatalog", func(t *testing.T) {
+		catalog := &KVCatalog{Source: source, Options: &ValidationOptions{DuplicateHandling: DuplicateSkip}}
+		set, err := catalog.Load(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"B"}, set.Names())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := (&ConsulSource{Address: server.URL, Key: "missing", Token: "secret"}).Get(context.Background())
+		assert.EqualError(t, err, "consul key missing not found")
+
+		_, _, err = (&ConsulSource{Address: server.URL, Key: "enums/status"}).Get(context.Background())
+		assert.EqualError(t, err, "consul returned 403 Forbidden: ACL not found")
+	})
+}
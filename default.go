@@ -0,0 +1,67 @@
+package goenum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetDefault declares the fallback enum of the set and returns the EnumSet for chaining.
+// It panics if the enum is not registered in the set, unless in panic-free mode.
+func (es *EnumSet[T]) SetDefault(enum T) *EnumSet[T] {
+	if !es.Contains(enum) {
+		es.fail(fmt.Errorf("default enum %s is not registered", enum.String()))
+		return es
+	}
+	es.defaultEnum = enum
+	es.hasDefault = true
+	return es
+}
+
+// Default returns the default enum of the set, if one was declared
+func (es *EnumSet[T]) Default() (T, bool) {
+	return es.defaultEnum, es.hasDefault
+}
+
+// GetByNameOrDefault retrieves an enum by name or alias, falling back to the default enum
+// (the zero value when no default is declared)
+func (es *EnumSet[T]) GetByNameOrDefault(name string) T {
+	if enum, exists := es.GetByName(name); exists {
+		return enum
+	}
+	return es.defaultEnum
+}
+
+// Parse resolves a string such as a config or environment value by name, alias,
+// the string form of a value, or a migrated name or value, returning an
+// *UnknownEnumError if nothing matches
+func (es *EnumSet[T]) Parse(s string) (T, error) {
+	s = strings.TrimSpace(s)
+	if enum, exists := es.resolveName(s); exists {
+		return enum, nil
+	}
+	for _, enum := range es.Values() {
+		if fmt.Sprint(enum.Value()) == s {
+			return enum, nil
+		}
+	}
+	if es.migrations != nil {
+		// Retired values are tried in the order they were added, since several may print alike
+		for _, old := range es.migrations.valueOrder {
+			if fmt.Sprint(old) == s {
+				enum, _ := es.GetByName(es.migrations.values[old])
+				return enum, nil
+			}
+		}
+	}
+	var zero T
+	return zero, newUnknownEnumError(s, es)
+}
+
+// ParseOrDefault resolves a string like Parse, falling back to the default enum
+// (the zero value when no default is declared)
+func (es *EnumSet[T]) ParseOrDefault(s string) T {
+	if enum, err := es.Parse(s); err == nil {
+		return enum
+	}
+	return es.defaultEnum
+}
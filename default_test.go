@@ -0,0 +1,52 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetDefault(t *testing.T) {
+	set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).Register(TestEnumC)
+
+	t.Run("no default", func(t *testing.T) {
+		_, ok := set.Default()
+		assert.False(t, ok, "Default() should report no default")
+		assert.Nil(t, set.GetByNameOrDefault("MISSING").EnumBase, "GetByNameOrDefault() should return the zero enum")
+		assert.Nil(t, set.ParseOrDefault("MISSING").EnumBase, "ParseOrDefault() should return the zero enum")
+	})
+
+	t.Run("SetDefault()", func(t *testing.T) {
+		assert.Same(t, set, set.SetDefault(TestEnumB), "SetDefault() should return the set for chaining")
+		enum, ok := set.Default()
+		assert.True(t, ok)
+		assert.Equal(t, TestEnumB, enum, "Default() should return the declared default")
+
+		other := TestEnum{NewEnumBase(9, "Z", "Unregistered")}
+		assert.PanicsWithValue(t, "default enum Z is not registered", func() { set.SetDefault(other) })
+	})
+
+	t.Run("GetByNameOrDefault()", func(t *testing.T) {
+		assert.Equal(t, TestEnumC, set.GetByNameOrDefault("CHARLIE"), "GetByNameOrDefault() should resolve aliases")
+		assert.Equal(t, TestEnumB, set.GetByNameOrDefault("MISSING"), "GetByNameOrDefault() should fall back to the default")
+	})
+
+	t.Run("Parse()", func(t *testing.T) {
+		enum, err := set.Parse(" alpha ")
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumA, enum, "Parse() should trim and resolve aliases")
+
+		enum, err = set.Parse("3")
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumC, enum, "Parse() should resolve string values")
+
+		_, err = set.Parse("7")
+		assert.ErrorIs(t, err, ErrUnknownEnum)
+	})
+
+	t.Run("ParseOrDefault()", func(t *testing.T) {
+		assert.Equal(t, TestEnumA, set.ParseOrDefault("1"))
+		assert.Equal(t, TestEnumB, set.ParseOrDefault(""), "empty input should fall back to the default")
+		assert.Equal(t, TestEnumB, set.ParseOrDefault("bogus"), "unknown input should fall back to the default")
+	})
+}
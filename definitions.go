@@ -0,0 +1,22 @@
+package goenum
+
+// NewEnumSetFromDefinitions builds a set from in-memory definitions, validated and
+// registered in order like LoadFromSlice. A nil opts uses the default validation options;
+// a rejected definition is returned as *DefinitionError.
+func NewEnumSetFromDefinitions(defs []EnumDefinition, opts *ValidationOptions) (*EnumSet[Enum], error) {
+	loader := NewDynamicEnumLoader(opts)
+	if err := loader.LoadFromSlice(defs); err != nil {
+		return nil, err
+	}
+	return loader.GetEnumSet(), nil
+}
+
+// Definitions returns the definitions of the enums in the set in registration order,
+// the model ExportToJSON writes and NewEnumSetFromDefinitions reads
+func (es *EnumSet[T]) Definitions() []EnumDefinition {
+	definitions := make([]EnumDefinition, 0, len(es.values))
+	for _, enum := range es.Values() {
+		definitions = append(definitions, definitionOf(enum))
+	}
+	return definitions
+}
@@ -0,0 +1,42 @@
+package goenum
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefinitions(t *testing.T) {
+	definitions := []EnumDefinition{
+		{Name: "PENDING", Value: 0, Description: "Waiting", Aliases: []string{"WAITING"}},
+		{Name: "ACTIVE", Value: 1, Description: "Running", Group: "open", Tags: []string{"billing"}},
+	}
+
+	t.Run("NewEnumSetFromDefinitions()", func(t *testing.T) {
+		set, err := NewEnumSetFromDefinitions(definitions, nil)
+		assert.NoError(t, err)
+		enum, exists := set.GetByName("waiting")
+		assert.True(t, exists)
+		assert.Equal(t, "PENDING", enum.String())
+		assert.Equal(t, definitions, set.Definitions(), "definitions should round-trip in order")
+	})
+
+	t.Run("rejected definitions", func(t *testing.T) {
+		options := DefaultValidationOptions()
+		options.RequireDescription = true
+		_, err := NewEnumSetFromDefinitions(append(definitions, EnumDefinition{Name: "CLOSED", Value: 2}), options)
+		var defErr *DefinitionError
+		assert.True(t, errors.As(err, &defErr))
+		assert.Equal(t, 2, defErr.Index)
+	})
+
+	t.Run("Definitions() of any set", func(t *testing.T) {
+		assert.Equal(t, []EnumDefinition{
+			{Name: "A", Value: 1, Description: "First enum", Aliases: []string{"ALPHA"}},
+			{Name: "B", Value: 2, Description: "Second enum", Aliases: []string{"BETA"}},
+			{Name: "C", Value: 3, Description: "Third enum", Aliases: []string{"CHARLIE", "THIRD"}},
+		}, TestEnumSet.Definitions())
+		assert.Empty(t, NewEnumSet[TestEnum]().Definitions())
+	})
+}
@@ -0,0 +1,191 @@
+package goenum
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// deprecatedAliasEnum is implemented by enums that can mark aliases as deprecated
+type deprecatedAliasEnum interface {
+	DeprecatedAliases() []string
+}
+
+// retiredEnum is implemented by enums that can be deprecated as a whole
+type retiredEnum interface {
+	IsDeprecated() bool
+}
+
+// isDeprecated reports whether an enum has been deprecated with Deprecate
+func isDeprecated(enum Enum) bool {
+	r, ok := enum.(retiredEnum)
+	return ok && r.IsDeprecated()
+}
+
+// DeprecatedAliasUsage reports how a deprecated alias has been resolved
+type DeprecatedAliasUsage struct {
+	Enum  string
+	Alias string
+	// Count is the number of times the alias was resolved by GetByName
+	Count int
+	// LastCaller is the file:line outside this package of the most recent resolution
+	LastCaller string
+}
+
+// deprecationTracker counts deprecated alias resolutions of an EnumSet
+type deprecationTracker struct {
+	mu    sync.Mutex
+	usage map[string]*DeprecatedAliasUsage
+	hook  func(DeprecatedAliasUsage)
+}
+
+// WithDeprecatedAliases marks aliases as deprecated, adding any the enum does not have yet,
+// and returns the EnumBase for chaining. Deprecated aliases still resolve, but every
+// resolution is counted and reported to the set's OnDeprecatedAlias hook.
+func (e *EnumBase) WithDeprecatedAliases(aliases ...string) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	// Copy on write, since readers of published sets may hold the current slices
+	updated := &EnumBase{aliases: append([]string(nil), e.aliases...)}
+	deprecated := append([]string(nil), e.deprecated...)
+	for _, alias := range aliases {
+		if !updated.HasAlias(alias) {
+			updated.aliases = append(updated.aliases, alias)
+		}
+		deprecated = append(deprecated, alias)
+	}
+	e.aliases, e.deprecated = updated.aliases, deprecated
+	if e.owner != nil {
+		e.owner.ownerIndexAliases(e.name)
+	}
+	return e
+}
+
+// Deprecate marks the enum itself as deprecated and returns the EnumBase for chaining.
+// Deprecated enums still resolve, so stored values keep working, but are offered as
+// disabled by Options.
+func (e *EnumBase) Deprecate() *EnumBase {
+	if e == nil {
+		return nil
+	}
+	e.retired = true
+	return e
+}
+
+// IsDeprecated reports whether the enum has been marked with Deprecate
+func (e *EnumBase) IsDeprecated() bool {
+	return e != nil && e.retired
+}
+
+// DeprecatedAliases returns the aliases marked as deprecated
+func (e *EnumBase) DeprecatedAliases() []string {
+	if e == nil {
+		return nil
+	}
+	return e.deprecated
+}
+
+// IsDeprecatedAlias checks if alias is one of the enum's deprecated aliases
+func (e *EnumBase) IsDeprecatedAlias(alias string) bool {
+	for _, a := range e.DeprecatedAliases() {
+		if strings.EqualFold(a, alias) {
+			return true
+		}
+	}
+	return false
+}
+
+// OnDeprecatedAlias installs a hook called every time GetByName resolves a deprecated alias
+// and returns the EnumSet for chaining
+func (es *EnumSet[T]) OnDeprecatedAlias(hook func(usage DeprecatedAliasUsage)) *EnumSet[T] {
+	es.deprecation.mu.Lock()
+	es.deprecation.hook = hook
+	es.deprecation.mu.Unlock()
+	return es
+}
+
+// DeprecatedAliasReport summarizes the usage of every deprecated alias in the set,
+// including unused ones, in registration order and sorted by alias
+func (es *EnumSet[T]) DeprecatedAliasReport() []DeprecatedAliasUsage {
+	es.deprecation.mu.Lock()
+	defer es.deprecation.mu.Unlock()
+
+	report := make([]DeprecatedAliasUsage, 0)
+	for _, enum := range es.Values() {
+		deprecated, ok := Enum(enum).(deprecatedAliasEnum)
+		if !ok {
+			continue
+		}
+		aliases := append([]string(nil), deprecated.DeprecatedAliases()...)
+		sort.Strings(aliases)
+		for _, alias := range aliases {
+			usage := DeprecatedAliasUsage{Enum: enum.String(), Alias: alias}
+			if recorded, exists := es.deprecation.usage[strings.ToUpper(alias)]; exists {
+				usage = *recorded
+			}
+			report = append(report, usage)
+		}
+	}
+	return report
+}
+
+// trackDeprecatedAlias records a resolution of alias if it is deprecated on enum
+func (es *EnumSet[T]) trackDeprecatedAlias(enum T, alias string) bool {
+	deprecated, ok := Enum(enum).(deprecatedAliasEnum)
+	if !ok {
+		return false
+	}
+	var canonical string
+	for _, a := range deprecated.DeprecatedAliases() {
+		if strings.EqualFold(a, alias) {
+			canonical = a
+			break
+		}
+	}
+	if canonical == "" {
+		return false
+	}
+
+	es.deprecation.mu.Lock()
+	if es.deprecation.usage == nil {
+		es.deprecation.usage = make(map[string]*DeprecatedAliasUsage)
+	}
+	key := strings.ToUpper(canonical)
+	usage, exists := es.deprecation.usage[key]
+	if !exists {
+		usage = &DeprecatedAliasUsage{Enum: enum.String(), Alias: canonical}
+		es.deprecation.usage[key] = usage
+	}
+	usage.Count++
+	usage.LastCaller = externalCaller()
+	snapshot := *usage
+	hook := es.deprecation.hook
+	es.deprecation.mu.Unlock()
+
+	if hook != nil {
+		hook(snapshot)
+	}
+	return true
+}
+
+// packagePath is the import path of this package
+var packagePath = reflect.TypeOf(EnumBase{}).PkgPath()
+
+// externalCaller returns the file:line of the first caller outside this package
+func externalCaller() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePath+".") || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
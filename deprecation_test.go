@@ -0,0 +1,83 @@
+package goenum
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecatedAliases(t *testing.T) {
+	newSet := func() (*EnumSet[*EnumBase], *EnumBase) {
+		active := NewEnumBase(1, "ACTIVE", "Active", "RUNNING", "ENABLED").
+			WithDeprecatedAliases("ENABLED", "ON")
+		pending := NewEnumBase(0, "PENDING", "Pending", "WAITING")
+		return NewEnumSet[*EnumBase]().Register(active).Register(pending), active
+	}
+
+	t.Run("WithDeprecatedAliases()", func(t *testing.T) {
+		_, active := newSet()
+		assert.Equal(t, []string{"RUNNING", "ENABLED", "ON"}, active.Aliases(), "missing deprecated aliases should be added")
+		assert.Equal(t, []string{"ENABLED", "ON"}, active.DeprecatedAliases())
+		assert.True(t, active.IsDeprecatedAlias("on"), "IsDeprecatedAlias() should be case-insensitive")
+		assert.False(t, active.IsDeprecatedAlias("RUNNING"))
+		assert.Nil(t, (*EnumBase)(nil).WithDeprecatedAliases("X"), "nil enum should stay nil")
+	})
+
+	t.Run("resolution is tracked", func(t *testing.T) {
+		set, active := newSet()
+		var uses []DeprecatedAliasUsage
+		set.OnDeprecatedAlias(func(usage DeprecatedAliasUsage) {
+			uses = append(uses, usage)
+		})
+
+		enum, exists := set.GetByName("enabled")
+		assert.True(t, exists, "deprecated aliases should still resolve")
+		assert.Same(t, active, enum)
+		set.GetByName("ENABLED")
+		set.GetByName("RUNNING")
+		set.GetByName("ACTIVE")
+
+		assert.Len(t, uses, 2, "hook should fire only for deprecated aliases")
+		assert.Equal(t, "ACTIVE", uses[1].Enum)
+		assert.Equal(t, "ENABLED", uses[1].Alias, "the declared alias spelling should be reported")
+		assert.Equal(t, 2, uses[1].Count)
+		assert.True(t, strings.Contains(uses[1].LastCaller, "deprecation_test.go:"), "caller should be outside the package internals, got %s", uses[1].LastCaller)
+	})
+
+	t.Run("DeprecatedAliasReport()", func(t *testing.T) {
+		set, _ := newSet()
+		set.GetByName("ON")
+		report := set.DeprecatedAliasReport()
+		assert.Len(t, report, 2, "report should include unused deprecated aliases")
+		assert.Equal(t, DeprecatedAliasUsage{Enum: "ACTIVE", Alias: "ENABLED"}, report[0])
+		assert.Equal(t, "ON", report[1].Alias)
+		assert.Equal(t, 1, report[1].Count)
+	})
+
+	t.Run("metrics event", func(t *testing.T) {
+		set, _ := newSet()
+		counter := NewLookupCounter()
+		set.SetMetrics(counter)
+		set.GetByName("ON")
+		set.GetByName("RUNNING")
+		assert.Equal(t, map[string]int{"ON": 1}, counter.Counts(LookupDeprecatedAliasHit))
+		assert.Equal(t, map[string]int{"RUNNING": 1}, counter.Counts(LookupAliasHit), "deprecated hits should not be counted as alias hits")
+		assert.Equal(t, "deprecated_alias_hit", LookupDeprecatedAliasHit.String())
+	})
+
+	t.Run("concurrent resolution", func(t *testing.T) {
+		set, _ := newSet()
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				set.GetByName("ON")
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 20, set.DeprecatedAliasReport()[1].Count)
+	})
+}
@@ -0,0 +1,209 @@
+package goenum
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// PluralRule returns the CLDR plural category of n in a language:
+// "zero", "one", "two", "few", "many" or "other"
+type PluralRule func(n float64) string
+
+// pluralRules holds the plural rules by lower-case language tag
+var pluralRules = struct {
+	sync.RWMutex
+	rules map[string]PluralRule
+}{rules: map[string]PluralRule{
+	"fr": pluralOneBelowTwo,
+	"pt": pluralOneBelowTwo,
+	"ru": pluralSlavic(false),
+	"uk": pluralSlavic(false),
+	"pl": pluralSlavic(true),
+	"ja": pluralOther,
+	"ko": pluralOther,
+	"zh": pluralOther,
+}}
+
+// RegisterPluralRule sets the plural rule of a language, e.g. "cs" or "pt-PT".
+// Languages without a rule, or whose base language has none, use the English rule.
+func RegisterPluralRule(language string, rule PluralRule) {
+	pluralRules.Lock()
+	defer pluralRules.Unlock()
+	pluralRules.rules[strings.ToLower(language)] = rule
+}
+
+// pluralRuleFor returns the rule of language, falling back to its base language
+// ("de" for "de-AT") and then to English
+func pluralRuleFor(language string) PluralRule {
+	language = strings.ToLower(language)
+	pluralRules.RLock()
+	defer pluralRules.RUnlock()
+	for language != "" {
+		if rule, exists := pluralRules.rules[language]; exists {
+			return rule
+		}
+		cut := strings.LastIndexAny(language, "-_")
+		if cut < 0 {
+			break
+		}
+		language = language[:cut]
+	}
+	return pluralEnglish
+}
+
+// pluralEnglish is "one" for exactly 1, as in English, German, Spanish or Italian
+func pluralEnglish(n float64) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// pluralOneBelowTwo is "one" for 0 up to, not including, 2, as in French
+func pluralOneBelowTwo(n float64) string {
+	if n >= 0 && n < 2 {
+		return "one"
+	}
+	return "other"
+}
+
+// pluralOther has no plural forms, as in Japanese or Chinese
+func pluralOther(float64) string {
+	return "other"
+}
+
+// pluralSlavic returns the one/few/many rule of Russian and Ukrainian, or of Polish,
+// where only 1 itself is "one"
+func pluralSlavic(polish bool) PluralRule {
+	return func(n float64) string {
+		if n != math.Trunc(n) {
+			return "other"
+		}
+		i := int64(math.Abs(n))
+		mod10, mod100 := i%10, i%100
+		switch {
+		case polish && i == 1, !polish && mod10 == 1 && mod100 != 11:
+			return "one"
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return "few"
+		}
+		return "many"
+	}
+}
+
+// DescribeWith returns the description with its placeholders filled from args, using the
+// English plural rule. Descriptions use a subset of ICU message syntax:
+//
+//	"Retried {count} times"
+//	"Retried {count, plural, =0 {never} one {once} other {# times}}"
+//
+// "#" stands for the number inside a plural case. Placeholders missing from args, or
+// plural placeholders without a numeric argument, are left as they are.
+func (e *EnumBase) DescribeWith(args map[string]any) string {
+	return e.DescribeWithLocale("", args)
+}
+
+// DescribeWithLocale is DescribeWith choosing plural cases with the rule of language,
+// as registered with RegisterPluralRule
+func (e *EnumBase) DescribeWithLocale(language string, args map[string]any) string {
+	if e == nil {
+		return ""
+	}
+	return formatMessage(e.description, pluralRuleFor(language), args, "")
+}
+
+// formatMessage fills the placeholders of message from args, replacing "#" outside
+// placeholders with hash unless it is empty
+func formatMessage(message string, rule PluralRule, args map[string]any, hash string) string {
+	var b strings.Builder
+	for i := 0; i < len(message); i++ {
+		switch c := message[i]; {
+		case c == '{':
+			end := closingBrace(message, i)
+			if end < 0 {
+				b.WriteString(message[i:])
+				return b.String()
+			}
+			b.WriteString(formatPlaceholder(message[i:end+1], rule, args))
+			i = end
+		case c == '#' && hash != "":
+			b.WriteString(hash)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// formatPlaceholder formats a single "{...}" placeholder
+func formatPlaceholder(placeholder string, rule PluralRule, args map[string]any) string {
+	parts := strings.SplitN(placeholder[1:len(placeholder)-1], ",", 3)
+	key := strings.TrimSpace(parts[0])
+	arg, exists := args[key]
+	if !exists {
+		return placeholder
+	}
+	if len(parts) == 1 {
+		return fmt.Sprint(arg)
+	}
+	n, ok := numericValue(arg)
+	if len(parts) < 3 || strings.TrimSpace(parts[1]) != "plural" || !ok {
+		return placeholder
+	}
+
+	cases, ok := pluralCases(parts[2])
+	if !ok {
+		return placeholder
+	}
+	text, exists := cases[fmt.Sprintf("=%v", n)]
+	if !exists {
+		text, exists = cases[rule(n)]
+	}
+	if !exists {
+		text, exists = cases["other"]
+	}
+	if !exists {
+		return placeholder
+	}
+	return formatMessage(text, rule, args, fmt.Sprint(arg))
+}
+
+// pluralCases parses `one {text} other {text}` into texts by selector
+func pluralCases(s string) (map[string]string, bool) {
+	cases := make(map[string]string)
+	for {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return cases, len(cases) > 0
+		}
+		open := strings.IndexByte(s, '{')
+		if open <= 0 {
+			return nil, false
+		}
+		end := closingBrace(s, open)
+		if end < 0 {
+			return nil, false
+		}
+		cases[strings.TrimSpace(s[:open])] = s[open+1 : end]
+		s = s[end+1:]
+	}
+}
+
+// closingBrace returns the index of the brace closing the one at open, or -1
+func closingBrace(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
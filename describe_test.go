@@ -0,0 +1,65 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeWith(t *testing.T) {
+	retried := NewEnumBase(1, "RETRIED", "Retried {count, plural, =0 {never} one {once} other {# times}} by {worker}")
+
+	t.Run("placeholders", func(t *testing.T) {
+		enum := NewEnumBase(1, "FAILED", "Failed after {count} attempts on {host}")
+		assert.Equal(t, "Failed after 3 attempts on db-1", enum.DescribeWith(map[string]any{"count": 3, "host": "db-1"}))
+		assert.Equal(t, "Failed after 3 attempts on {host}", enum.DescribeWith(map[string]any{"count": 3}))
+		assert.Equal(t, "Failed after {count} attempts on {host}", enum.DescribeWith(nil))
+	})
+
+	t.Run("plural forms", func(t *testing.T) {
+		args := map[string]any{"worker": "w1"}
+		for count, expected := range map[any]string{
+			0:   "Retried never by w1",
+			1:   "Retried once by w1",
+			5:   "Retried 5 times by w1",
+			1.5: "Retried 1.5 times by w1",
+		} {
+			args["count"] = count
+			assert.Equal(t, expected, retried.DescribeWith(args))
+		}
+		assert.Equal(t, "Retried {count, plural, =0 {never} one {once} other {# times}} by w1",
+			retried.DescribeWith(map[string]any{"count": "many", "worker": "w1"}))
+	})
+
+	t.Run("locale-aware plural forms", func(t *testing.T) {
+		files := NewEnumBase(1, "FILES", "{n, plural, one {# plik} few {# pliki} many {# plików} other {# pliku}}")
+		assert.Equal(t, "1 plik", files.DescribeWithLocale("pl", map[string]any{"n": 1}))
+		assert.Equal(t, "3 pliki", files.DescribeWithLocale("pl-PL", map[string]any{"n": 3}))
+		assert.Equal(t, "12 plików", files.DescribeWithLocale("pl", map[string]any{"n": 12}))
+		assert.Equal(t, "22 pliki", files.DescribeWithLocale("pl", map[string]any{"n": 22}))
+		assert.Equal(t, "21 plików", files.DescribeWithLocale("pl", map[string]any{"n": 21}))
+
+		assert.Equal(t, "Retried once by w1", retried.DescribeWithLocale("fr", map[string]any{"count": 1, "worker": "w1"}))
+		assert.Equal(t, "Retried 5 times by w1", retried.DescribeWithLocale("ja", map[string]any{"count": 5, "worker": "w1"}))
+		assert.Equal(t, "Retried 1 times by w1", retried.DescribeWithLocale("ja", map[string]any{"count": 1, "worker": "w1"}))
+	})
+
+	t.Run("RegisterPluralRule()", func(t *testing.T) {
+		RegisterPluralRule("x-test", func(n float64) string { return "few" })
+		t.Cleanup(func() {
+			pluralRules.Lock()
+			delete(pluralRules.rules, "x-test")
+			pluralRules.Unlock()
+		})
+		enum := NewEnumBase(1, "E", "{n, plural, few {some} other {many}}")
+		assert.Equal(t, "some", enum.DescribeWithLocale("X-TEST", map[string]any{"n": 9}))
+		assert.Equal(t, "many", enum.DescribeWithLocale("en", map[string]any{"n": 9}))
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		assert.Equal(t, "open {count", NewEnumBase(1, "E", "open {count").DescribeWith(map[string]any{"count": 1}))
+		assert.Equal(t, "{count, plural, one}", NewEnumBase(1, "E", "{count, plural, one}").DescribeWith(map[string]any{"count": 1}))
+		var nilEnum *EnumBase
+		assert.Equal(t, "", nilEnum.DescribeWith(nil))
+	})
+}
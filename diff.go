@@ -0,0 +1,194 @@
+package goenum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EnumDiff reports the differences between two enum catalogs
+type EnumDiff struct {
+	Added   []EnumDefinition
+	Removed []EnumDefinition
+	Changed []EnumChange
+}
+
+// EnumChange reports an enum present in both catalogs whose definition changed
+type EnumChange struct {
+	Name string
+	Old  EnumDefinition
+	New  EnumDefinition
+	// Fields lists the changed fields: "value", "description", "aliases", "group" and/or "displayName"
+	Fields []string
+}
+
+// Diff compares two enum sets, matching enums by name
+func Diff(a, b AnyEnumSet) EnumDiff {
+	return DiffDefinitions(definitionsOf(a), definitionsOf(b))
+}
+
+// DiffDefinitions compares two lists of enum definitions, e.g. loaded from definition files,
+// matching enums by name case-insensitively
+func DiffDefinitions(a, b []EnumDefinition) EnumDiff {
+	old := definitionsByName(a)
+	current := definitionsByName(b)
+	diff := EnumDiff{}
+
+	for _, name := range sortedKeys(old) {
+		oldDef := old[name]
+		newDef, exists := current[name]
+		if !exists {
+			diff.Removed = append(diff.Removed, oldDef)
+			continue
+		}
+		if fields := changedFields(oldDef, newDef); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, EnumChange{Name: newDef.Name, Old: oldDef, New: newDef, Fields: fields})
+		}
+	}
+	for _, name := range sortedKeys(current) {
+		if _, exists := old[name]; !exists {
+			diff.Added = append(diff.Added, current[name])
+		}
+	}
+	return diff
+}
+
+// IsEmpty reports whether the catalogs are identical
+func (d EnumDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// IsBreaking reports whether the diff can break existing consumers: a removed enum,
+// a changed value or a removed alias
+func (d EnumDiff) IsBreaking() bool {
+	if len(d.Removed) > 0 {
+		return true
+	}
+	for _, change := range d.Changed {
+		if change.Has("value") || len(removedAliases(change.Old.Aliases, change.New.Aliases)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Has reports whether field changed
+func (c EnumChange) Has(field string) bool {
+	for _, f := range c.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats the diff with one line per difference, prefixed with "+" for added,
+// "-" for removed and "~" for changed enums, e.g. "~ ACTIVE: value 1 -> 2"
+func (d EnumDiff) String() string {
+	var b strings.Builder
+	for _, def := range d.Added {
+		fmt.Fprintf(&b, "+ %s (value: %v)\n", def.Name, def.Value)
+	}
+	for _, def := range d.Removed {
+		fmt.Fprintf(&b, "- %s (value: %v)\n", def.Name, def.Value)
+	}
+	for _, change := range d.Changed {
+		for _, field := range change.Fields {
+			switch field {
+			case "value":
+				fmt.Fprintf(&b, "~ %s: value %v -> %v\n", change.Name, change.Old.Value, change.New.Value)
+			case "description":
+				fmt.Fprintf(&b, "~ %s: description %q -> %q\n", change.Name, change.Old.Description, change.New.Description)
+			case "aliases":
+				fmt.Fprintf(&b, "~ %s: aliases %v -> %v\n", change.Name, change.Old.Aliases, change.New.Aliases)
+			case "group":
+				fmt.Fprintf(&b, "~ %s: group %q -> %q\n", change.Name, change.Old.Group, change.New.Group)
+			case "displayName":
+				fmt.Fprintf(&b, "~ %s: display name %q -> %q\n", change.Name, change.Old.DisplayName, change.New.DisplayName)
+			}
+		}
+	}
+	return b.String()
+}
+
+// definitionsOf returns the definitions of every enum in a set
+func definitionsOf(set AnyEnumSet) []EnumDefinition {
+	if set == nil {
+		return nil
+	}
+	names := set.Names()
+	definitions := make([]EnumDefinition, 0, len(names))
+	for _, name := range names {
+		if enum, exists := set.EnumByName(name); exists {
+			definitions = append(definitions, definitionOf(enum))
+		}
+	}
+	return definitions
+}
+
+// definitionsByName indexes definitions by upper-cased name
+func definitionsByName(definitions []EnumDefinition) map[string]EnumDefinition {
+	result := make(map[string]EnumDefinition, len(definitions))
+	for _, def := range definitions {
+		result[strings.ToUpper(def.Name)] = def
+	}
+	return result
+}
+
+// sortedKeys returns the keys of a map such as a definition index, sorted
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// changedFields lists the fields that differ between two definitions of the same enum
+func changedFields(a, b EnumDefinition) []string {
+	var fields []string
+	if !reflect.DeepEqual(normalizeDefinitionValue(a.Value), normalizeDefinitionValue(b.Value)) {
+		fields = append(fields, "value")
+	}
+	if a.Description != b.Description {
+		fields = append(fields, "description")
+	}
+	if len(removedAliases(a.Aliases, b.Aliases)) > 0 || len(removedAliases(b.Aliases, a.Aliases)) > 0 {
+		fields = append(fields, "aliases")
+	}
+	if a.Group != b.Group {
+		fields = append(fields, "group")
+	}
+	if a.DisplayName != b.DisplayName {
+		fields = append(fields, "displayName")
+	}
+	return fields
+}
+
+// removedAliases returns the aliases of old missing from current, ignoring case
+func removedAliases(old, current []string) []string {
+	var removed []string
+	for _, alias := range old {
+		found := false
+		for _, c := range current {
+			if strings.EqualFold(alias, c) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, alias)
+		}
+	}
+	return removed
+}
+
+// normalizeDefinitionValue converts whole float64 values decoded from JSON to int
+func normalizeDefinitionValue(value interface{}) interface{} {
+	if f, ok := value.(float64); ok && f == float64(int(f)) {
+		return int(f)
+	}
+	return value
+}
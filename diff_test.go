@@ -0,0 +1,70 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	oldSet := NewEnumSet[*EnumBase]().
+		Register(NewEnumBase(0, "PENDING", "Waiting", "WAITING")).
+		Register(NewEnumBase(1, "ACTIVE", "Active", "RUNNING", "ON")).
+		Register(NewEnumBase(2, "DELETED", "Deleted"))
+	newSet := NewEnumSet[*EnumBase]().
+		Register(NewEnumBase(0, "PENDING", "Waiting to start", "WAITING")).
+		Register(NewEnumBase(5, "ACTIVE", "Active", "running")).
+		Register(NewEnumBase(3, "ARCHIVED", "Archived").WithGroup("closed"))
+
+	t.Run("Diff()", func(t *testing.T) {
+		diff := Diff(oldSet, newSet)
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, []EnumDefinition{{Name: "ARCHIVED", Value: 3, Description: "Archived", Group: "closed"}}, diff.Added)
+		assert.Equal(t, []EnumDefinition{{Name: "DELETED", Value: 2, Description: "Deleted"}}, diff.Removed)
+		assert.Len(t, diff.Changed, 2)
+		assert.Equal(t, "ACTIVE", diff.Changed[0].Name)
+		assert.Equal(t, []string{"value", "aliases"}, diff.Changed[0].Fields, "alias case changes should be ignored")
+		assert.Equal(t, []string{"description"}, diff.Changed[1].Fields)
+		assert.True(t, diff.Changed[0].Has("value"))
+		assert.True(t, diff.IsBreaking(), "removals and value changes are breaking")
+	})
+
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, `+ ARCHIVED (value: 3)
+- DELETED (value: 2)
+~ ACTIVE: value 1 -> 5
+~ ACTIVE: aliases [RUNNING ON] -> [running]
+~ PENDING: description "Waiting" -> "Waiting to start"
+`, Diff(oldSet, newSet).String())
+	})
+
+	t.Run("identical sets", func(t *testing.T) {
+		diff := Diff(oldSet, oldSet)
+		assert.True(t, diff.IsEmpty(), "a set should not differ from itself")
+		assert.False(t, diff.IsBreaking())
+		assert.Empty(t, diff.String())
+	})
+
+	t.Run("non-breaking changes", func(t *testing.T) {
+		diff := DiffDefinitions(
+			[]EnumDefinition{{Name: "A", Value: 1, Aliases: []string{"X"}}},
+			[]EnumDefinition{{Name: "A", Value: 1, Description: "new", Aliases: []string{"X", "Y"}}, {Name: "B", Value: 2}},
+		)
+		assert.False(t, diff.IsBreaking(), "additions should not be breaking")
+
+		diff = DiffDefinitions(
+			[]EnumDefinition{{Name: "A", Value: 1, Aliases: []string{"X"}}},
+			[]EnumDefinition{{Name: "A", Value: 1}},
+		)
+		assert.True(t, diff.IsBreaking(), "removed aliases should be breaking")
+	})
+
+	t.Run("DiffDefinitions() from files", func(t *testing.T) {
+		var fromFile []EnumDefinition
+		assert.NoError(t, json.Unmarshal([]byte(`[{"name": "pending", "value": 0, "description": "Waiting", "aliases": ["WAITING"]}]`), &fromFile))
+		diff := DiffDefinitions(fromFile, definitionsOf(oldSet))
+		assert.Empty(t, diff.Changed, "JSON numbers and name casing should not count as changes")
+		assert.Len(t, diff.Added, 2)
+	})
+}
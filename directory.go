@@ -0,0 +1,155 @@
+package goenum
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// SymlinkPolicy defines how directory loading treats symbolic links
+type SymlinkPolicy int
+
+const (
+	// SymlinkFollow loads linked files and, when recursive, descends into linked directories
+	SymlinkFollow SymlinkPolicy = iota
+	// SymlinkSkip ignores symbolic links
+	SymlinkSkip
+	// SymlinkError returns an error when a symbolic link is found
+	SymlinkError
+)
+
+// DirectoryOptions defines which files LoadFromDirectoryWithOptions loads
+type DirectoryOptions struct {
+	// Recursive descends into subdirectories
+	Recursive bool
+	// Include lists glob patterns (path.Match syntax) a file must match to be loaded.
+	// Patterns containing a slash match the path relative to the root directory,
+	// others match the file name. Empty includes "*.json" and the extensions of
+	// formats added with RegisterLoaderFormat.
+	Include []string
+	// Exclude lists glob patterns for files and directories to skip, matched like Include
+	Exclude []string
+	// Symlinks specifies how symbolic links are handled
+	Symlinks SymlinkPolicy
+}
+
+// LoadFromDirectoryWithOptions loads the definition files in a directory selected by
+// options, in lexical path order, decoding each with LoadFromFile. A nil options loads
+// the files of every known format in dir only, like LoadFromDirectory.
+func (l *DynamicEnumLoader) LoadFromDirectoryWithOptions(dir string, options *DirectoryOptions) error {
+	if options == nil {
+		options = &DirectoryOptions{}
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return fmt.Errorf("directory does not exist: %s", dir)
+	}
+	for _, pattern := range append(append([]string{}, options.Include...), options.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	include := options.Include
+	if len(include) == 0 {
+		include = loaderFormatPatterns()
+	}
+	walker := &directoryWalker{options: options, include: include, visited: make(map[string]bool)}
+	if err := walker.walk(dir, ""); err != nil {
+		return err
+	}
+
+	if len(walker.files) == 0 {
+		return fmt.Errorf("no files matching %s found in directory: %s", strings.Join(include, ", "), dir)
+	}
+
+	for _, file := range walker.files {
+		if err := l.LoadFromFile(file); err != nil {
+			if _, ok := err.(*DefinitionError); ok {
+				return err // Already names the file
+			}
+			return fmt.Errorf("failed to load file %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// directoryWalker collects the files selected by DirectoryOptions
+type directoryWalker struct {
+	options *DirectoryOptions
+	include []string
+	visited map[string]bool
+	files   []string
+}
+
+// walk collects the selected files in dir, whose path relative to the root is rel
+func (w *directoryWalker) walk(dir, rel string) error {
+	// Guard against symlink cycles
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+	if w.visited[resolved] {
+		return nil
+	}
+	w.visited[resolved] = true
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		full := filepath.Join(dir, name)
+		relative := path.Join(rel, name)
+		if matchesAny(w.options.Exclude, relative) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to read directory: %w", err)
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			switch w.options.Symlinks {
+			case SymlinkSkip:
+				continue
+			case SymlinkError:
+				return fmt.Errorf("symbolic link found: %s", full)
+			}
+			if info, err = os.Stat(full); err != nil {
+				return fmt.Errorf("failed to resolve symbolic link %s: %w", full, err)
+			}
+		}
+
+		if info.IsDir() {
+			if w.options.Recursive {
+				if err := w.walk(full, relative); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if info.Mode().IsRegular() && matchesAny(w.include, relative) {
+			w.files = append(w.files, full)
+		}
+	}
+	return nil
+}
+
+// matchesAny reports whether the relative path matches any of patterns
+func matchesAny(patterns []string, relative string) bool {
+	for _, pattern := range patterns {
+		target := path.Base(relative)
+		if strings.Contains(pattern, "/") {
+			target = relative
+		}
+		if matched, _ := path.Match(pattern, target); matched {
+			return true
+		}
+	}
+	return false
+}
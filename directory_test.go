@@ -0,0 +1,113 @@
+package goenum
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeCatalogTree creates files under root, mapping relative paths to contents
+func writeCatalogTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+		assert.NoError(t, os.WriteFile(file, []byte(content), 0644))
+	}
+}
+
+func TestLoadFromDirectoryWithOptions(t *testing.T) {
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+	root := t.TempDir()
+	writeCatalogTree(t, root, map[string]string{
+		"root.json":                  `[{"name": "ROOT", "value": 1}]`,
+		"billing/invoice.json":       `[{"name": "INVOICE", "value": 2}]`,
+		"billing/legacy/old.json":    `[{"name": "OLD", "value": 3}]`,
+		"shipping/parcel.enum.json":  `[{"name": "PARCEL", "value": 4}]`,
+		"shipping/notes.txt":         `not json`,
+		"shipping/draft/broken.json": `not json`,
+	})
+
+	names := func(loader *DynamicEnumLoader) []string {
+		return loader.GetEnumSet().Names()
+	}
+
+	t.Run("nil options matches LoadFromDirectory", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		assert.NoError(t, loader.LoadFromDirectoryWithOptions(root, nil))
+		assert.ElementsMatch(t, []string{"ROOT"}, names(loader))
+	})
+
+	t.Run("recursive with exclude", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{
+			Recursive: true,
+			Exclude:   []string{"draft", "billing/legacy"},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"ROOT", "INVOICE", "PARCEL"}, names(loader))
+	})
+
+	t.Run("include patterns", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{
+			Recursive: true,
+			Include:   []string{"*.enum.json", "billing/*.json"},
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"INVOICE", "PARCEL"}, names(loader))
+	})
+
+	t.Run("invalid file fails", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{Recursive: true})
+		assert.ErrorContains(t, err, "broken.json")
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{Include: []string{"["}})
+		assert.ErrorContains(t, err, "invalid pattern")
+	})
+
+	t.Run("no matching files", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{Include: []string{"*.yaml"}})
+		assert.ErrorContains(t, err, "no files matching *.yaml found in directory")
+	})
+}
+
+func TestLoadFromDirectorySymlinks(t *testing.T) {
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+	root := t.TempDir()
+	shared := t.TempDir()
+	writeCatalogTree(t, root, map[string]string{"local.json": `[{"name": "LOCAL", "value": 1}]`})
+	writeCatalogTree(t, shared, map[string]string{"shared.json": `[{"name": "SHARED", "value": 2}]`})
+	if err := os.Symlink(shared, filepath.Join(root, "shared")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	// A link back to the root must not loop forever
+	assert.NoError(t, os.Symlink(root, filepath.Join(shared, "loop")))
+
+	t.Run("follow", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{Recursive: true})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"LOCAL", "SHARED"}, loader.GetEnumSet().Names())
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{Recursive: true, Symlinks: SymlinkSkip})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"LOCAL"}, loader.GetEnumSet().Names())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromDirectoryWithOptions(root, &DirectoryOptions{Recursive: true, Symlinks: SymlinkError})
+		assert.ErrorContains(t, err, "symbolic link found")
+	})
+}
@@ -0,0 +1,40 @@
+package goenum
+
+// displayNamedEnum is implemented by enums with a human-readable display name
+type displayNamedEnum interface {
+	DisplayName() string
+}
+
+// displayNameOf returns the display name of an enum, or an empty string if it has none
+func displayNameOf(enum Enum) string {
+	if d, ok := enum.(displayNamedEnum); ok {
+		return d.DisplayName()
+	}
+	return ""
+}
+
+// labelOf returns the display name of an enum, falling back to its name
+func labelOf(enum Enum) string {
+	if name := displayNameOf(enum); name != "" {
+		return name
+	}
+	return enum.String()
+}
+
+// WithDisplayName sets a human-readable label such as "In Progress", distinct from the
+// machine name used for lookups and serialization, and returns the EnumBase for chaining
+func (e *EnumBase) WithDisplayName(name string) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	e.displayName = name
+	return e
+}
+
+// DisplayName returns the display name of the enum, or an empty string if it has none
+func (e *EnumBase) DisplayName() string {
+	if e == nil {
+		return ""
+	}
+	return e.displayName
+}
@@ -0,0 +1,77 @@
+package goenum
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplayName(t *testing.T) {
+	inProgress := NewEnumBase(1, "IN_PROGRESS", "Work has started").WithDisplayName("In Progress")
+	done := NewEnumBase(2, "DONE", "Work is finished")
+	set := NewEnumSet[*EnumBase]().Register(inProgress).Register(done)
+
+	t.Run("WithDisplayName()", func(t *testing.T) {
+		assert.Equal(t, "In Progress", inProgress.DisplayName())
+		assert.Equal(t, "IN_PROGRESS", inProgress.String(), "the machine name should not change")
+		assert.Equal(t, "", done.DisplayName())
+		var nilEnum *EnumBase
+		assert.Equal(t, "", nilEnum.DisplayName())
+		assert.Nil(t, nilEnum.WithDisplayName("x"))
+	})
+
+	t.Run("Options() labels", func(t *testing.T) {
+		options := set.Options()
+		assert.Equal(t, "In Progress", options[0].Label)
+		assert.Equal(t, "DONE", options[1].Label)
+	})
+
+	t.Run("full JSON format", func(t *testing.T) {
+		config := &EnumJSONConfig{Format: JSONFormatFull}
+		data, err := inProgress.MarshalJSONWithConfig(config)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"IN_PROGRESS","value":1,"description":"Work has started","displayName":"In Progress"}`, string(data))
+
+		data, err = done.MarshalJSONWithConfig(config)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "displayName")
+
+		decoded := &EnumBase{}
+		assert.NoError(t, decoded.UnmarshalJSONWithConfig([]byte(`{"name":"IN_PROGRESS","value":1,"displayName":"In Progress"}`), config))
+		assert.Equal(t, "In Progress", decoded.DisplayName())
+	})
+
+	t.Run("definitions", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		err := loader.LoadFromReader(strings.NewReader(`[{"name":"IN_PROGRESS","value":1,"displayName":"In Progress"}]`))
+		assert.NoError(t, err)
+		enum, _ := loader.GetEnumSet().GetByName("IN_PROGRESS")
+		assert.Equal(t, "In Progress", displayNameOf(enum))
+		assert.Equal(t, "In Progress", definitionOf(enum).DisplayName)
+		assert.NoError(t, ValidateDefinitions([]byte(`[{"name":"A","value":1,"displayName":"A"}]`)))
+
+		diff := DiffDefinitions(
+			[]EnumDefinition{{Name: "A", Value: 1, DisplayName: "Old"}},
+			[]EnumDefinition{{Name: "A", Value: 1, DisplayName: "New"}})
+		assert.Equal(t, []string{"displayName"}, diff.Changed[0].Fields)
+		assert.Equal(t, "~ A: display name \"Old\" -> \"New\"\n", diff.String())
+	})
+
+	t.Run("Dump() columns", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, set.DumpMarkdown(&buf))
+		expected := "" +
+			"| Name | Display Name | Value | Aliases | Description |\n" +
+			"| --- | --- | --- | --- | --- |\n" +
+			"| IN_PROGRESS | In Progress | 1 |  | Work has started |\n" +
+			"| DONE |  | 2 |  | Work is finished |\n"
+		assert.Equal(t, expected, buf.String())
+
+		buf.Reset()
+		assert.NoError(t, set.Dump(&buf))
+		assert.True(t, strings.HasPrefix(buf.String(), "NAME         DISPLAY NAME  VALUE"))
+	})
+
+}
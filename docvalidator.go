@@ -0,0 +1,115 @@
+package goenum
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DocumentValidator checks a whole definition document before any definition is loaded,
+// e.g. against a CUE or JSON Schema file maintained by the teams editing the definitions.
+// Errors that are, or join, *SchemaError values keep their paths and positions; any other
+// error becomes a violation of the whole document.
+//
+// The format is the lower-case extension of the document's file with a leading dot, such
+// as ".json" or ".hcl", or empty when the loader does not know it, so validators can skip
+// formats they don't understand.
+type DocumentValidator interface {
+	ValidateDocument(format string, data []byte) error
+}
+
+// DocumentValidatorFunc adapts a function to DocumentValidator
+type DocumentValidatorFunc func(format string, data []byte) error
+
+// ValidateDocument calls f(format, data)
+func (f DocumentValidatorFunc) ValidateDocument(format string, data []byte) error {
+	return f(format, data)
+}
+
+// BuiltinSchemaValidator checks JSON documents against DefinitionSchema, accepting
+// documents of other formats
+var BuiltinSchemaValidator DocumentValidator = DocumentValidatorFunc(func(format string, data []byte) error {
+	if format != ".json" {
+		return nil
+	}
+	return ValidateDefinitions(data)
+})
+
+// DocumentError reports the violations found by the document validators, which stop
+// the document from being loaded
+type DocumentError struct {
+	Violations []*SchemaError
+}
+
+// Error implements the error interface, listing every violation
+func (e *DocumentError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Error()
+	}
+	return fmt.Sprintf("definition document has %d violation(s): %s", len(e.Violations), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the violations for errors.As
+func (e *DocumentError) Unwrap() []error {
+	errs := make([]error, len(e.Violations))
+	for i, violation := range e.Violations {
+		errs[i] = violation
+	}
+	return errs
+}
+
+// validatedDocument runs the document validators of the options on the document of the
+// given format in reader, returning a reader over the same document, or reader itself if
+// there are none
+func (l *DynamicEnumLoader) validatedDocument(format string, reader io.Reader) (io.Reader, error) {
+	reader, violations, err := l.documentViolations(format, reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, &DocumentError{Violations: violations}
+	}
+	return reader, nil
+}
+
+// documentViolations runs the document validators of the options on the document of the
+// given format in reader, returning their violations and a reader over the same document
+func (l *DynamicEnumLoader) documentViolations(format string, reader io.Reader) (io.Reader, []*SchemaError, error) {
+	if len(l.options.DocumentValidators) == 0 {
+		return reader, nil, nil
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	var violations []*SchemaError
+	for _, validator := range l.options.DocumentValidators {
+		violations = append(violations, schemaViolations(validator.ValidateDocument(format, data))...)
+	}
+	return bytes.NewReader(data), violations, nil
+}
+
+// schemaViolations flattens an error returned by a DocumentValidator into violations
+func schemaViolations(err error) []*SchemaError {
+	if err == nil {
+		return nil
+	}
+	if schemaErr, ok := err.(*SchemaError); ok {
+		return []*SchemaError{schemaErr}
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var violations []*SchemaError
+		for _, err := range joined.Unwrap() {
+			violations = append(violations, schemaViolations(err)...)
+		}
+		return violations
+	}
+	var schemaErr *SchemaError
+	if errors.As(err, &schemaErr) {
+		return []*SchemaError{schemaErr}
+	}
+	return []*SchemaError{{Path: "$", Message: err.Error()}}
+}
@@ -0,0 +1,80 @@
+package goenum
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentValidators(t *testing.T) {
+	// rejectCodes stands in for a user-supplied JSON Schema or CUE validator
+	rejectCodes := DocumentValidatorFunc(func(format string, data []byte) error {
+		if strings.Contains(string(data), "CODE_") {
+			return errors.Join(
+				&SchemaError{Path: "$[0].name", Message: "must not start with CODE_"},
+				errors.New("codes are managed elsewhere"),
+			)
+		}
+		return nil
+	})
+	newLoader := func(validators ...DocumentValidator) *DynamicEnumLoader {
+		options := DefaultValidationOptions()
+		options.DocumentValidators = validators
+		return NewDynamicEnumLoader(options)
+	}
+
+	t.Run("valid documents load", func(t *testing.T) {
+		loader := newLoader(rejectCodes, BuiltinSchemaValidator)
+		assert.NoError(t, loader.LoadFromReader(strings.NewReader(`[{"name": "ACTIVE", "value": 1}]`)))
+		_, exists := loader.GetEnumSet().GetByName("ACTIVE")
+		assert.True(t, exists)
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		loader := newLoader(rejectCodes, BuiltinSchemaValidator)
+		err := loader.LoadFromReader(strings.NewReader(`[{"name": "CODE_A", "value": 1, "colour": "red"}]`))
+
+		var documentErr *DocumentError
+		if assert.ErrorAs(t, err, &documentErr) {
+			assert.Len(t, documentErr.Violations, 3)
+			assert.Equal(t, "$[0].name: must not start with CODE_", documentErr.Violations[0].Error())
+			assert.Equal(t, "$: codes are managed elsewhere", documentErr.Violations[1].Error())
+			assert.Equal(t, 1, documentErr.Violations[2].Line, "schema violations should keep their positions")
+		}
+		var schemaErr *SchemaError
+		assert.ErrorAs(t, err, &schemaErr)
+		assert.Empty(t, loader.GetEnumSet().Values(), "nothing should be loaded")
+	})
+
+	t.Run("other formats", func(t *testing.T) {
+		loader := newLoader(rejectCodes)
+		err := loader.LoadFromCodec(strings.NewReader(`enum "CODE_A" { value = 1 }`), HCLCodec)
+		var documentErr *DocumentError
+		assert.ErrorAs(t, err, &documentErr)
+	})
+
+	t.Run("mixed-format directories", func(t *testing.T) {
+		RegisterLoaderFormat(".hcl", HCLCodec)
+		t.Cleanup(func() { UnregisterLoaderFormat(".hcl") })
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`[{"name": "ACTIVE", "value": 1}]`), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "b.hcl"), []byte(`enum "PAUSED" { value = 2 }`), 0644))
+
+		var formats []string
+		recordFormats := DocumentValidatorFunc(func(format string, data []byte) error {
+			formats = append(formats, format)
+			return nil
+		})
+		loader := newLoader(BuiltinSchemaValidator, recordFormats)
+		assert.NoError(t, loader.LoadFromDirectory(dir), "the builtin schema should accept other formats")
+		assert.Equal(t, []string{".json", ".hcl"}, formats)
+		assert.Len(t, loader.GetEnumSet().Values(), 2)
+
+		assert.NoError(t, newLoader(recordFormats).LoadFromHCL(filepath.Join(dir, "b.hcl")))
+		assert.Equal(t, ".hcl", formats[len(formats)-1])
+	})
+}
@@ -0,0 +1,83 @@
+package goenum
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// dumpRows returns the name, value, aliases and description of every enum, in registration order,
+// with the display name after the name when any enum has one
+func (es *EnumSet[T]) dumpRows() (rows [][]string, withDisplayNames bool) {
+	withDisplayNames = es.Any(func(enum T) bool { return displayNameOf(enum) != "" })
+	rows = make([][]string, 0, len(es.values))
+	for _, enum := range es.Values() {
+		row := []string{enum.String()}
+		if withDisplayNames {
+			row = append(row, displayNameOf(enum))
+		}
+		rows = append(rows, append(row,
+			fmt.Sprint(enum.Value()),
+			strings.Join(enum.Aliases(), ", "),
+			enum.Description(),
+		))
+	}
+	return rows, withDisplayNames
+}
+
+// dumpHeader returns the column titles of Dump, or of DumpMarkdown when markdown is set
+func dumpHeader(markdown, withDisplayNames bool) []string {
+	header := []string{"NAME", "DISPLAY NAME", "VALUE", "ALIASES", "DESCRIPTION"}
+	if markdown {
+		header = []string{"Name", "Display Name", "Value", "Aliases", "Description"}
+	}
+	if !withDisplayNames {
+		header = append(header[:1], header[2:]...)
+	}
+	return header
+}
+
+// Dump writes the set as an aligned text table of names, display names, values, aliases and descriptions
+func (es *EnumSet[T]) Dump(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	rows, withDisplayNames := es.dumpRows()
+	for _, row := range append([][]string{dumpHeader(false, withDisplayNames)}, rows...) {
+		if _, err := fmt.Fprintln(tw, strings.TrimRight(strings.Join(row, "\t"), "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// DumpMarkdown writes the set as a markdown table of names, display names, values, aliases and descriptions
+func (es *EnumSet[T]) DumpMarkdown(w io.Writer) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	writeRow := func(row []string) error {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = escape.Replace(cell)
+		}
+		_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+		return err
+	}
+
+	rows, withDisplayNames := es.dumpRows()
+	header := dumpHeader(true, withDisplayNames)
+	separators := make([]string, len(header))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	if err := writeRow(header); err != nil {
+		return err
+	}
+	if err := writeRow(separators); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
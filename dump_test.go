@@ -0,0 +1,42 @@
+package goenum
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetDump(t *testing.T) {
+	t.Run("Dump() text table", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, TestEnumSet.Dump(&buf))
+		expected := "" +
+			"NAME  VALUE  ALIASES         DESCRIPTION\n" +
+			"A     1      ALPHA           First enum\n" +
+			"B     2      BETA            Second enum\n" +
+			"C     3      CHARLIE, THIRD  Third enum\n"
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("DumpMarkdown() table", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().
+			Register(TestEnumA).
+			Register(TestEnum{NewEnumBase(2, "PIPE", "a|b")})
+
+		var buf bytes.Buffer
+		assert.NoError(t, set.DumpMarkdown(&buf))
+		expected := "" +
+			"| Name | Value | Aliases | Description |\n" +
+			"| --- | --- | --- | --- |\n" +
+			"| A | 1 | ALPHA | First enum |\n" +
+			"| PIPE | 2 |  | a\\|b |\n"
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("empty set", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, NewEnumSet[TestEnum]().Dump(&buf))
+		assert.Equal(t, "NAME  VALUE  ALIASES  DESCRIPTION\n", buf.String())
+	})
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 )
 
 // DuplicateHandling defines how to handle duplicate enums during loading
@@ -25,21 +27,82 @@ const (
 type ValidationOptions struct {
 	// DuplicateHandling specifies how to handle duplicate enums
 	DuplicateHandling DuplicateHandling
+	// AliasHandling specifies how to handle an alias already used by another enum:
+	// DuplicateError rejects the definition, DuplicateSkip drops the alias, and
+	// DuplicateOverride moves it from the other enum to the new one. An alias equal
+	// to another enum's name is rejected or dropped, as names take precedence.
+	AliasHandling DuplicateHandling
 	// ValueType specifies the expected type for enum values (e.g., reflect.TypeOf(0) for int)
 	ValueType reflect.Type
 	// AllowEmptyNames allows enums with empty names
 	AllowEmptyNames bool
 	// AllowEmptyValues allows enums with nil values
 	AllowEmptyValues bool
+	// JSONC accepts JSON input with // and /* */ comments and trailing commas
+	JSONC bool
+	// Intern shares identical description, alias, group and tag strings between loaded enums
+	// and allocates enums in blocks, reducing the heap usage of very large catalogs that
+	// repeat the same strings at the cost of slower loading. The loader retains every
+	// interned string for its lifetime so later loads share them; discard the loader
+	// after loading to release strings no longer used by the enum set
+	Intern bool
+	// NamePattern, if not nil, rejects enums whose names don't match it (e.g. ^[A-Z][A-Z0-9_]*$)
+	NamePattern *regexp.Regexp
+	// MinValue, if not nil, rejects numeric enum values below it
+	MinValue *float64
+	// MaxValue, if not nil, rejects numeric enum values above it
+	MaxValue *float64
+	// AllowedValues, if not empty, rejects numeric enum values not in it
+	AllowedValues []float64
+	// RequireDescription rejects enums without a description
+	RequireDescription bool
+	// MinAliases rejects enums with fewer aliases
+	MinAliases int
+	// MaxAliases, if positive, rejects enums with more aliases
+	MaxAliases int
+	// Validators are called for each definition after the built-in checks; the first
+	// error rejects the definition
+	Validators []func(EnumDefinition) error
+	// ExportOrder orders the definitions written by ExportToJSON and ExportToWriter
+	ExportOrder ExportOrder
+	// DocumentValidators check each whole document, in the format of its file, before
+	// any of its definitions is loaded; violations are returned as *DocumentError.
+	// LoadFromStream, which never holds a whole document, does not run them, nor do
+	// LoadFromMap, LoadFromSlice and LoadFromEnv, which load no document.
+	DocumentValidators []DocumentValidator
 }
 
+// ExportOrder defines the order of exported definitions
+type ExportOrder int
+
+const (
+	// ExportRegistrationOrder exports enums in the order they were loaded (default)
+	ExportRegistrationOrder ExportOrder = iota
+	// ExportByName sorts exported enums by name
+	ExportByName
+	// ExportByValue sorts exported enums by value, numbers numerically
+	ExportByValue
+)
+
 // DefaultValidationOptions returns the default validation options
 func DefaultValidationOptions() *ValidationOptions {
 	return &ValidationOptions{
-		DuplicateHandling: DuplicateError,
-		ValueType:         nil, // No type restriction by default
-		AllowEmptyNames:   false,
-		AllowEmptyValues:  false,
+		DuplicateHandling:  DuplicateError,
+		AliasHandling:      DuplicateError,
+		ValueType:          nil, // No type restriction by default
+		AllowEmptyNames:    false,
+		AllowEmptyValues:   false,
+		JSONC:              false,
+		Intern:             false,
+		NamePattern:        nil, // No naming convention by default
+		MinValue:           nil, // No value constraints by default
+		MaxValue:           nil,
+		AllowedValues:      nil,
+		RequireDescription: false,
+		MinAliases:         0,
+		MaxAliases:         0, // No alias limit by default
+		Validators:         nil,
+		ExportOrder:        ExportRegistrationOrder,
 	}
 }
 
@@ -49,12 +112,42 @@ type EnumDefinition struct {
 	Value       interface{} `json:"value"`
 	Description string      `json:"description"`
 	Aliases     []string    `json:"aliases,omitempty"`
+	Group       string      `json:"group,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
+	// Meta holds free-form metadata, such as a display order, read with Meta
+	Meta map[string]interface{} `json:"meta,omitempty"`
+}
+
+// DefinitionOf returns the EnumDefinition describing an enum, as exported by ExportToJSON
+func DefinitionOf(enum Enum) EnumDefinition {
+	return definitionOf(enum)
+}
+
+// definitionOf builds the EnumDefinition describing an enum
+func definitionOf(enum Enum) EnumDefinition {
+	return EnumDefinition{
+		Name:        enum.String(),
+		Value:       enum.Value(),
+		Description: enum.Description(),
+		Aliases:     enum.Aliases(),
+		Group:       groupOf(enum),
+		DisplayName: displayNameOf(enum),
+		Tags:        tagsOf(enum),
+		Meta:        metadataOf(enum),
+	}
 }
 
 // DynamicEnumLoader provides functionality to load enums from various sources
 type DynamicEnumLoader struct {
-	enumSet *EnumSet[Enum]
-	options *ValidationOptions
+	enumSet    *EnumSet[Enum]
+	options    *ValidationOptions
+	migrations map[string]string
+	aliases    map[string]string // upper-case alias to the name of the enum loaded with it
+	warnings   []LoadWarning
+	interned   map[string]string // retained across loads, see ValidationOptions.Intern
+	arena      []EnumBase
+	shared     bool // the enums are shared with another loader and must not be changed
 }
 
 // NewDynamicEnumLoader creates a new DynamicEnumLoader instance
@@ -75,11 +168,21 @@ func (l *DynamicEnumLoader) validateEnumDefinition(def EnumDefinition) error {
 		return fmt.Errorf("enum name cannot be empty")
 	}
 
+	// Check the naming convention
+	if l.options.NamePattern != nil && def.Name != "" && !l.options.NamePattern.MatchString(def.Name) {
+		return fmt.Errorf("enum name %q does not match pattern %s", def.Name, l.options.NamePattern)
+	}
+
 	// Check for empty value
 	if !l.options.AllowEmptyValues && def.Value == nil {
 		return fmt.Errorf("enum value cannot be nil")
 	}
 
+	// Lists and objects cannot be looked up by value
+	if def.Value != nil && !reflect.TypeOf(def.Value).Comparable() {
+		return fmt.Errorf("enum value must be a string, number or boolean, not %T", def.Value)
+	}
+
 	// Check value type if specified
 	if l.options.ValueType != nil && def.Value != nil {
 		valueType := reflect.TypeOf(def.Value)
@@ -89,32 +192,207 @@ func (l *DynamicEnumLoader) validateEnumDefinition(def EnumDefinition) error {
 		}
 	}
 
+	// Check required fields
+	if l.options.RequireDescription && strings.TrimSpace(def.Description) == "" {
+		return fmt.Errorf("enum %s must have a description", def.Name)
+	}
+	if len(def.Aliases) < l.options.MinAliases {
+		return fmt.Errorf("enum %s has %d aliases, at least %d required", def.Name, len(def.Aliases), l.options.MinAliases)
+	}
+	if l.options.MaxAliases > 0 && len(def.Aliases) > l.options.MaxAliases {
+		return fmt.Errorf("enum %s has %d aliases, at most %d allowed", def.Name, len(def.Aliases), l.options.MaxAliases)
+	}
+
+	if err := l.validateValueConstraints(def); err != nil {
+		return err
+	}
+
+	// Run custom validators
+	for _, validate := range l.options.Validators {
+		if err := validate(def); err != nil {
+			return fmt.Errorf("enum %s: %w", def.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateValueConstraints checks a numeric enum value against MinValue, MaxValue and AllowedValues
+func (l *DynamicEnumLoader) validateValueConstraints(def EnumDefinition) error {
+	options := l.options
+	if (options.MinValue == nil && options.MaxValue == nil && len(options.AllowedValues) == 0) || def.Value == nil {
+		return nil
+	}
+
+	value, ok := numericValue(def.Value)
+	if !ok {
+		return fmt.Errorf("enum %s value %v is not numeric", def.Name, def.Value)
+	}
+	if options.MinValue != nil && value < *options.MinValue {
+		return fmt.Errorf("enum %s value %v is below the minimum %v", def.Name, def.Value, *options.MinValue)
+	}
+	if options.MaxValue != nil && value > *options.MaxValue {
+		return fmt.Errorf("enum %s value %v is above the maximum %v", def.Name, def.Value, *options.MaxValue)
+	}
+	if len(options.AllowedValues) > 0 {
+		for _, allowed := range options.AllowedValues {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("enum %s value %v is not one of the allowed values %v", def.Name, def.Value, options.AllowedValues)
+	}
 	return nil
 }
 
-// handleDuplicate handles duplicate enum according to the options
-func (l *DynamicEnumLoader) handleDuplicate(name string, value interface{}) error {
+// numericValue converts an integer or floating-point value to float64
+func numericValue(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// handleDuplicate handles a definition whose name or value is already loaded according
+// to the options, reporting whether the definition should be skipped
+func (l *DynamicEnumLoader) handleDuplicate(name string, value interface{}) (bool, error) {
+	existing, exists := l.enumSet.values[name]
+	if !exists {
+		existing, exists = l.enumSet.byValue[value]
+	}
+	if !exists {
+		return false, nil
+	}
+
 	switch l.options.DuplicateHandling {
 	case DuplicateError:
-		return fmt.Errorf("duplicate enum found: name=%s, value=%v", name, value)
+		return false, fmt.Errorf("duplicate enum found: name=%s, value=%v", name, value)
 	case DuplicateSkip:
-		return nil // Skip this enum
+		l.warn(WarningDuplicateSkipped, name, "duplicate of %s (value=%v) skipped", existing.String(), existing.Value())
+		return true, nil
 	case DuplicateOverride:
-		// Remove existing enum before adding new one
-		if _, exists := l.enumSet.GetByName(name); exists {
-			// Create a new set and copy all enums except the one to override
-			newSet := NewEnumSet[Enum]()
-			for _, enum := range l.enumSet.Values() {
-				if enum.String() != name {
-					newSet.Register(enum)
-				}
+		// Remove the existing enums with the same name or value before adding the new one
+		l.enumSet.Unregister(name)
+		if existing, exists := l.enumSet.byValue[value]; exists {
+			l.enumSet.Unregister(existing.String())
+		}
+	}
+	return false, nil
+}
+
+// handleAliasCollisions applies AliasHandling to the aliases of def used by other enums,
+// updating def.Aliases to the aliases it keeps
+func (l *DynamicEnumLoader) handleAliasCollisions(def *EnumDefinition) error {
+	if len(def.Aliases) == 0 {
+		return nil
+	}
+
+	aliases := make([]string, 0, len(def.Aliases))
+	for _, alias := range def.Aliases {
+		if strings.TrimSpace(alias) == "" {
+			l.warn(WarningEmptyAlias, def.Name, "alias %q is empty", alias)
+		}
+
+		// Names take precedence over aliases in GetByName
+		if named, exists := l.enumSet.values[strings.ToUpper(alias)]; exists && named.String() != def.Name {
+			if l.options.AliasHandling == DuplicateError {
+				return fmt.Errorf("duplicate alias found: alias=%s is the name of another enum", alias)
+			}
+			l.warn(WarningAliasSkipped, def.Name, "alias %s is the name of another enum, skipped", alias)
+			continue
+		}
+
+		owner, exists := l.aliasOwner(alias, def.Name)
+		if !exists {
+			aliases = append(aliases, alias)
+			continue
+		}
+		switch l.options.AliasHandling {
+		case DuplicateError:
+			return fmt.Errorf("duplicate alias found: alias=%s, used by %s", alias, owner.String())
+		case DuplicateSkip:
+			l.warn(WarningAliasSkipped, def.Name, "alias %s of %s skipped", alias, owner.String())
+			continue
+		case DuplicateOverride:
+			l.enumSet.unindexAliasOf(owner, alias)
+			if !l.shared {
+				owner.aliases = withoutAlias(owner.aliases, alias)
 			}
-			l.enumSet = newSet
+			aliases = append(aliases, alias)
 		}
 	}
+	def.Aliases = aliases
 	return nil
 }
 
+// aliasOwner returns the loaded enum other than name that has alias
+func (l *DynamicEnumLoader) aliasOwner(alias, name string) (*EnumBase, bool) {
+	ownerName, exists := l.aliases[strings.ToUpper(alias)]
+	if !exists || ownerName == name {
+		return nil, false
+	}
+	owner, ok := l.enumSet.values[ownerName].(*EnumBase)
+	if !ok || !owner.HasAlias(alias) {
+		return nil, false
+	}
+	return owner, true
+}
+
+// indexAliases records the aliases of a registered enum for collision checks
+func (l *DynamicEnumLoader) indexAliases(enum *EnumBase) {
+	if l.aliases == nil {
+		l.aliases = make(map[string]string)
+	}
+	for _, alias := range enum.aliases {
+		l.aliases[strings.ToUpper(alias)] = enum.name
+	}
+}
+
+// withoutAlias returns aliases without any alias equal to alias, ignoring case
+func withoutAlias(aliases []string, alias string) []string {
+	result := make([]string, 0, len(aliases))
+	for _, a := range aliases {
+		if !strings.EqualFold(a, alias) {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// AddMigration renames definitions using the retired name old to current while loading,
+// and maps old to current in the loaded set once current is registered.
+// It returns the loader for chaining.
+func (l *DynamicEnumLoader) AddMigration(old, current string) *DynamicEnumLoader {
+	if l.migrations == nil {
+		l.migrations = make(map[string]string)
+	}
+	l.migrations[strings.ToUpper(old)] = current
+	return l
+}
+
+// migrateName returns the current name for a retired name
+func (l *DynamicEnumLoader) migrateName(name string) string {
+	if current, exists := l.migrations[strings.ToUpper(name)]; exists {
+		return current
+	}
+	return name
+}
+
+// applyMigrations adds the loader's migrations whose target is loaded to the enum set
+func (l *DynamicEnumLoader) applyMigrations() {
+	for old, current := range l.migrations {
+		if _, exists := l.enumSet.values[strings.ToUpper(current)]; exists {
+			l.enumSet.AddMigration(old, current)
+		}
+	}
+}
+
 // LoadFromJSON loads enum definitions from a JSON file
 func (l *DynamicEnumLoader) LoadFromJSON(filename string) error {
 	file, err := os.Open(filename)
@@ -123,165 +401,204 @@ func (l *DynamicEnumLoader) LoadFromJSON(filename string) error {
 	}
 	defer file.Close()
 
-	return l.LoadFromReader(file)
+	return withFile(l.LoadFromReader(file), filename)
 }
 
-// LoadFromReader loads enum definitions from an io.Reader
+// LoadFromReader loads enum definitions from an io.Reader.
+// Rejected definitions are reported as *DefinitionError.
 func (l *DynamicEnumLoader) LoadFromReader(reader io.Reader) error {
-	var definitions []EnumDefinition
-	if err := json.NewDecoder(reader).Decode(&definitions); err != nil {
-		return fmt.Errorf("failed to decode JSON: %w", err)
+	type located struct {
+		def      EnumDefinition
+		position *DefinitionError
+	}
+	var definitions []located
+	reader, err := l.validatedDocument(".json", l.jsonReader(reader))
+	if err != nil {
+		return err
+	}
+	err = decodeDefinitions(reader, func(def EnumDefinition, position *DefinitionError) error {
+		definitions = append(definitions, located{def, position})
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	for _, def := range definitions {
-		// Validate the enum definition
-		if err := l.validateEnumDefinition(def); err != nil {
-			return fmt.Errorf("invalid enum definition: %w", err)
-		}
-
-		// Handle duplicates
-		if err := l.handleDuplicate(def.Name, def.Value); err != nil {
-			if l.options.DuplicateHandling == DuplicateError {
-				return err
-			}
-			continue // Skip this enum for DuplicateSkip
+	for _, d := range definitions {
+		if err := l.loadDefinition(d.def); err != nil {
+			d.position.Err = err
+			return d.position
 		}
+	}
 
-		// Convert float64 to int if necessary
-		if f, ok := def.Value.(float64); ok {
-			def.Value = int(f)
-		}
+	l.applyMigrations()
+	return nil
+}
 
-		enum := &EnumBase{
-			name:        def.Name,
-			value:       def.Value,
-			description: def.Description,
-			aliases:     def.Aliases,
-			jsonConfig:  DefaultJSONConfig(),
+// LoadFromStream loads enum definitions from a JSON array in an io.Reader one entry at a
+// time, so only a single definition is held in memory while decoding. Entries before an
+// invalid one stay registered when an error is returned.
+// Rejected definitions are reported as *DefinitionError.
+func (l *DynamicEnumLoader) LoadFromStream(reader io.Reader) error {
+	err := decodeDefinitions(l.jsonReader(reader), func(def EnumDefinition, position *DefinitionError) error {
+		if err := l.loadDefinition(def); err != nil {
+			position.Err = err
+			return position
 		}
-		l.enumSet.Register(enum)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	l.applyMigrations()
 	return nil
 }
 
-// LoadFromDirectory loads all JSON files from a directory
-func (l *DynamicEnumLoader) LoadFromDirectory(dir string) error {
-	// Check if directory exists
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		return fmt.Errorf("directory does not exist: %s", dir)
+// jsonReader strips JSONC syntax from reader when the options allow it
+func (l *DynamicEnumLoader) jsonReader(reader io.Reader) io.Reader {
+	if l.options.JSONC {
+		return newJSONCReader(reader)
 	}
+	return reader
+}
 
-	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
-	if err != nil {
-		return fmt.Errorf("failed to read directory: %w", err)
-	}
+// loadDefinition validates a decoded or in-memory definition and registers it
+func (l *DynamicEnumLoader) loadDefinition(def EnumDefinition) error {
+	def.Name = l.migrateName(def.Name)
 
-	if len(files) == 0 {
-		return fmt.Errorf("no JSON files found in directory: %s", dir)
+	// Validate the enum definition
+	if err := l.validateEnumDefinition(def); err != nil {
+		return fmt.Errorf("invalid enum definition: %w", err)
 	}
 
-	for _, file := range files {
-		if err := l.LoadFromJSON(file); err != nil {
-			return fmt.Errorf("failed to load file %s: %w", file, err)
+	// Convert float64 to int if necessary
+	if f, ok := def.Value.(float64); ok {
+		def.Value = int(f)
+		if float64(int(f)) != f {
+			l.warn(WarningValueCoerced, def.Name, "value %v truncated to %d", f, def.Value)
 		}
 	}
 
+	// Handle duplicates
+	skip, err := l.handleDuplicate(def.Name, def.Value)
+	if err != nil {
+		return err
+	}
+	if skip {
+		return nil
+	}
+
+	// Handle alias collisions
+	if err := l.handleAliasCollisions(&def); err != nil {
+		return err
+	}
+
+	enum := l.newEnum(def)
+	if err := l.enumSet.TryRegister(enum); err != nil {
+		return err
+	}
+	l.indexAliases(enum)
 	return nil
 }
 
+// LoadFromDirectory loads all JSON files, and files of formats added with
+// RegisterLoaderFormat, from a directory
+func (l *DynamicEnumLoader) LoadFromDirectory(dir string) error {
+	return l.LoadFromDirectoryWithOptions(dir, nil)
+}
+
 // GetEnumSet returns the loaded enum set
 func (l *DynamicEnumLoader) GetEnumSet() *EnumSet[Enum] {
 	return l.enumSet
 }
 
-// LoadFromMap loads enum definitions from a map
+// LoadFromMap loads enum definitions from a map, in the order of their keys.
+// Rejected definitions are reported as *DefinitionError.
 func (l *DynamicEnumLoader) LoadFromMap(definitions map[string]EnumDefinition) error {
-	for _, def := range definitions {
-		// Validate the enum definition
-		if err := l.validateEnumDefinition(def); err != nil {
-			return fmt.Errorf("invalid enum definition: %w", err)
-		}
-
-		// Handle duplicates
-		if err := l.handleDuplicate(def.Name, def.Value); err != nil {
-			if l.options.DuplicateHandling == DuplicateError {
-				return err
-			}
-			continue // Skip this enum for DuplicateSkip
-		}
-
-		enum := &EnumBase{
-			name:        def.Name,
-			value:       def.Value,
-			description: def.Description,
-			aliases:     def.Aliases,
-			jsonConfig:  DefaultJSONConfig(),
+	keys := make([]string, 0, len(definitions))
+	for key := range definitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		def := definitions[key]
+		if err := l.loadDefinition(def); err != nil {
+			return definitionErrorAt(-1, l.migrateName(def.Name), err)
 		}
-		l.enumSet.Register(enum)
 	}
+	l.applyMigrations()
 	return nil
 }
 
-// LoadFromSlice loads enum definitions from a slice
+// LoadFromSlice loads enum definitions from a slice.
+// Rejected definitions are reported as *DefinitionError.
 func (l *DynamicEnumLoader) LoadFromSlice(definitions []EnumDefinition) error {
-	for _, def := range definitions {
-		// Validate the enum definition
-		if err := l.validateEnumDefinition(def); err != nil {
-			return fmt.Errorf("invalid enum definition: %w", err)
+	for index, def := range definitions {
+		if err := l.loadDefinition(def); err != nil {
+			return definitionErrorAt(index, l.migrateName(def.Name), err)
 		}
+	}
+	l.applyMigrations()
+	return nil
+}
 
-		// Handle duplicates
-		if err := l.handleDuplicate(def.Name, def.Value); err != nil {
-			if l.options.DuplicateHandling == DuplicateError {
-				return err
-			}
-			continue // Skip this enum for DuplicateSkip
-		}
+// ExportToJSON exports the current enum set to a JSON file, ordered by the ExportOrder option
+func (l *DynamicEnumLoader) ExportToJSON(filename string) error {
+	return l.ExportToJSONFiltered(filename, nil)
+}
 
-		// Create a new enum set if we need to override
-		if l.options.DuplicateHandling == DuplicateOverride {
-			newSet := NewEnumSet[Enum]()
-			for _, enum := range l.enumSet.Values() {
-				if enum.String() != def.Name {
-					newSet.Register(enum)
-				}
-			}
-			l.enumSet = newSet
-		}
+// ExportToWriter writes the current enum set as JSON to w, like ExportToJSON
+func (l *DynamicEnumLoader) ExportToWriter(w io.Writer) error {
+	return l.ExportToWriterFiltered(w, nil)
+}
 
-		enum := &EnumBase{
-			name:        def.Name,
-			value:       def.Value,
-			description: def.Description,
-			aliases:     def.Aliases,
-			jsonConfig:  DefaultJSONConfig(),
-		}
+// ExportToJSONFiltered exports the enums satisfying the predicate to a JSON file, e.g. to
+// leave internal or deprecated enums out of a public catalog. A nil predicate exports all.
+func (l *DynamicEnumLoader) ExportToJSONFiltered(filename string, predicate func(Enum) bool) error {
+	data, err := l.exportJSON(predicate)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
 
-		// Only register if we're not skipping
-		if l.options.DuplicateHandling != DuplicateSkip || !l.enumSet.Contains(enum) {
-			l.enumSet.Register(enum)
-		}
+// ExportToWriterFiltered writes the enums satisfying the predicate as JSON to w,
+// like ExportToJSONFiltered
+func (l *DynamicEnumLoader) ExportToWriterFiltered(w io.Writer, predicate func(Enum) bool) error {
+	data, err := l.exportJSON(predicate)
+	if err != nil {
+		return err
 	}
-	return nil
+	_, err = w.Write(data)
+	return err
 }
 
-// ExportToJSON exports the current enum set to a JSON file
-func (l *DynamicEnumLoader) ExportToJSON(filename string) error {
-	definitions := make([]EnumDefinition, 0)
-	for _, enum := range l.enumSet.Values() {
-		definitions = append(definitions, EnumDefinition{
-			Name:        enum.String(),
-			Value:       enum.Value(),
-			Description: enum.Description(),
-			Aliases:     enum.Aliases(),
+// exportJSON marshals the definitions of the loaded enums satisfying the predicate,
+// if any, in export order
+func (l *DynamicEnumLoader) exportJSON(predicate func(Enum) bool) ([]byte, error) {
+	enums := l.enumSet.Values()
+	if predicate != nil {
+		enums = l.enumSet.Filter(predicate)
+	}
+	switch l.options.ExportOrder {
+	case ExportByName:
+		sort.SliceStable(enums, func(i, j int) bool {
+			return enums[i].String() < enums[j].String()
+		})
+	case ExportByValue:
+		sort.SliceStable(enums, func(i, j int) bool {
+			return lessValue(enums[i].Value(), enums[j].Value())
 		})
 	}
 
+	definitions := make([]EnumDefinition, 0, len(enums))
+	for _, enum := range enums {
+		definitions = append(definitions, definitionOf(enum))
+	}
 	data, err := json.MarshalIndent(definitions, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal enums: %w", err)
+		return nil, fmt.Errorf("failed to marshal enums: %w", err)
 	}
-
-	return os.WriteFile(filename, data, 0644)
+	return data, nil
 }
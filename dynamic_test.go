@@ -1,10 +1,17 @@
 package goenum
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -119,6 +126,62 @@ func TestDynamicEnumLoading(t *testing.T) {
 		assert.Equal(t, "TEST_A", exported[0].Name)
 		assert.Equal(t, "TEST_B", exported[1].Name)
 	})
+
+	t.Run("ExportToWriter", func(t *testing.T) {
+		export := func(order ExportOrder) []string {
+			options := DefaultValidationOptions()
+			options.ExportOrder = order
+			loader := NewDynamicEnumLoader(options)
+			assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{
+				{Name: "C", Value: 10},
+				{Name: "A", Value: 2},
+				{Name: "B", Value: 1},
+			}))
+			loader.GetEnumSet().Unregister("A")
+			assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{{Name: "A", Value: 3}}))
+
+			var buf bytes.Buffer
+			assert.NoError(t, loader.ExportToWriter(&buf))
+			var exported []EnumDefinition
+			assert.NoError(t, json.Unmarshal(buf.Bytes(), &exported))
+			names := make([]string, len(exported))
+			for i, def := range exported {
+				names[i] = def.Name
+			}
+			return names
+		}
+		assert.Equal(t, []string{"C", "B", "A"}, export(ExportRegistrationOrder))
+		assert.Equal(t, []string{"A", "B", "C"}, export(ExportByName))
+		assert.Equal(t, []string{"B", "A", "C"}, export(ExportByValue))
+	})
+
+	t.Run("ExportToJSONFiltered", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{
+			{Name: "ACTIVE", Value: 1},
+			{Name: "INTERNAL_DEBUG", Value: 2, Tags: []string{"internal"}},
+			{Name: "CLOSED", Value: 3},
+		}))
+		public := func(e Enum) bool { return !e.(*EnumBase).HasTag("internal") }
+
+		exportFile := filepath.Join(tempDir, "public.json")
+		assert.NoError(t, loader.ExportToJSONFiltered(exportFile, public))
+		data, err := os.ReadFile(exportFile)
+		assert.NoError(t, err)
+		var exported []EnumDefinition
+		assert.NoError(t, json.Unmarshal(data, &exported))
+		assert.Len(t, exported, 2)
+		assert.Equal(t, "ACTIVE", exported[0].Name)
+		assert.Equal(t, "CLOSED", exported[1].Name)
+
+		var buf bytes.Buffer
+		assert.NoError(t, loader.ExportToWriterFiltered(&buf, public))
+		assert.Equal(t, string(data), buf.String())
+
+		buf.Reset()
+		assert.NoError(t, loader.ExportToWriterFiltered(&buf, func(Enum) bool { return false }))
+		assert.Equal(t, "[]", buf.String())
+	})
 }
 
 func TestDynamicEnumLoadingErrors(t *testing.T) {
@@ -267,7 +330,7 @@ func TestDynamicEnumLoadingEdgeCases(t *testing.T) {
 			},
 			{
 				Name:  "FLOAT",
-				Value: 1.5,
+				Value: 2.5,
 			},
 			{
 				Name:  "STRING",
@@ -286,7 +349,10 @@ func TestDynamicEnumLoadingEdgeCases(t *testing.T) {
 		assert.Equal(t, 1, intEnum.Value())
 
 		floatEnum, _ := loader.GetEnumSet().GetByName("FLOAT")
-		assert.Equal(t, 1.5, floatEnum.Value())
+		assert.Equal(t, 2, floatEnum.Value(), "float64 values should be coerced to ints like JSON numbers")
+		if assert.Len(t, loader.Warnings(), 1) {
+			assert.Equal(t, WarningValueCoerced, loader.Warnings()[0].Kind)
+		}
 
 		stringEnum, _ := loader.GetEnumSet().GetByName("STRING")
 		assert.Equal(t, "test", stringEnum.Value())
@@ -360,7 +426,7 @@ func TestDynamicEnumLoadingEdgeCases(t *testing.T) {
 		loader := NewDynamicEnumLoader(options)
 		err = loader.LoadFromDirectory(emptyDir)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "no JSON files found")
+		assert.Contains(t, err.Error(), "no files matching *.json")
 	})
 
 	t.Run("load from directory with mixed file types", func(t *testing.T) {
@@ -439,6 +505,14 @@ func TestDynamicEnumValidation(t *testing.T) {
 		assert.Contains(t, err.Error(), "enum value cannot be nil")
 	})
 
+	t.Run("non-scalar value validation", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		err := loader.LoadFromReader(strings.NewReader(`[{"name": "A", "value": [1, 2]}]`))
+		assert.ErrorContains(t, err, "enum value must be a string, number or boolean, not []interface {}")
+		err = loader.LoadFromReader(strings.NewReader(`[{"name": "A", "value": {"a": 1}}]`))
+		assert.ErrorContains(t, err, "enum value must be a string, number or boolean, not map[string]interface {}")
+	})
+
 	t.Run("nil value allowed", func(t *testing.T) {
 		options := DefaultValidationOptions()
 		options.AllowEmptyValues = true
@@ -530,6 +604,28 @@ func TestDynamicEnumValidation(t *testing.T) {
 		assert.Equal(t, 2, enum.Value()) // Second value should override
 	})
 
+	t.Run("duplicate handling - override keeps the set", func(t *testing.T) {
+		options := DefaultValidationOptions()
+		options.DuplicateHandling = DuplicateOverride
+		loader := NewDynamicEnumLoader(options)
+		loader.GetEnumSet().AddIndex("description", func(e Enum) interface{} { return e.Description() })
+		set := loader.GetEnumSet()
+
+		err := loader.LoadFromReader(strings.NewReader(`[
+			{"name": "A", "value": 1, "description": "first"},
+			{"name": "B", "value": 2, "description": "second"},
+			{"name": "A", "value": 3, "description": "third"},
+			{"name": "C", "value": 2, "description": "fourth"}
+		]`))
+		assert.NoError(t, err)
+		assert.Same(t, set, loader.GetEnumSet(), "overrides should replace entries in place")
+		assert.ElementsMatch(t, []string{"A", "C"}, set.Names())
+		enum, _ := set.GetByIndex("description", "third")
+		assert.Equal(t, "A", enum.String(), "indexes should follow the replacement")
+		_, exists := set.GetByIndex("description", "first")
+		assert.False(t, exists, "indexes should drop the replaced enum")
+	})
+
 	t.Run("multiple validations", func(t *testing.T) {
 		options := DefaultValidationOptions()
 		options.ValueType = reflect.TypeOf("") // Expect string values
@@ -565,4 +661,225 @@ func TestDynamicEnumValidation(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "enum name cannot be empty")
 	})
+
+	t.Run("name pattern", func(t *testing.T) {
+		options := DefaultValidationOptions()
+		options.DuplicateHandling = DuplicateSkip
+		options.NamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromSlice([]EnumDefinition{{Name: "IN_PROGRESS", Value: 1}, {Name: "DONE2", Value: 2}})
+		assert.NoError(t, err)
+
+		for _, name := range []string{"InProgress", "in_progress", "2FA", "DONE-1"} {
+			loader := NewDynamicEnumLoader(options)
+			err := loader.LoadFromSlice([]EnumDefinition{{Name: name, Value: 1}})
+			assert.ErrorContains(t, err, "does not match pattern", name)
+		}
+
+		loader = NewDynamicEnumLoader(options)
+		err = loader.LoadFromReader(strings.NewReader(`[{"name": "Active", "value": 1}]`))
+		assert.ErrorContains(t, err, `enum name "Active" does not match pattern ^[A-Z][A-Z0-9_]*$`)
+	})
+
+	t.Run("value constraints", func(t *testing.T) {
+		minValue, maxValue := float64(math.MinInt16), float64(math.MaxInt16)
+		options := DefaultValidationOptions()
+		options.DuplicateHandling = DuplicateSkip
+		options.MinValue = &minValue
+		options.MaxValue = &maxValue
+
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromReader(strings.NewReader(`[{"name": "LOW", "value": -32768}, {"name": "HIGH", "value": 32767}]`))
+		assert.NoError(t, err)
+
+		loader = NewDynamicEnumLoader(options)
+		err = loader.LoadFromReader(strings.NewReader(`[{"name": "OVERFLOW", "value": 40000}]`))
+		assert.ErrorContains(t, err, "invalid enum definition: enum OVERFLOW value 40000 is above the maximum 32767")
+
+		loader = NewDynamicEnumLoader(options)
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "UNDERFLOW", Value: int64(-40000)}})
+		assert.ErrorContains(t, err, "is below the minimum -32768")
+
+		loader = NewDynamicEnumLoader(options)
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "TEXT", Value: "a"}})
+		assert.ErrorContains(t, err, "is not numeric")
+
+		options.MinValue, options.MaxValue = nil, nil
+		options.AllowedValues = []float64{0, 1, 2}
+		loader = NewDynamicEnumLoader(options)
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "ONE", Value: uint8(1)}})
+		assert.NoError(t, err)
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "THREE", Value: 3}})
+		assert.ErrorContains(t, err, "is not one of the allowed values [0 1 2]")
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		options := DefaultValidationOptions()
+		options.DuplicateHandling = DuplicateSkip
+		options.RequireDescription = true
+		options.MinAliases = 1
+		options.MaxAliases = 2
+
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromSlice([]EnumDefinition{{Name: "ACTIVE", Value: 1, Description: "Active", Aliases: []string{"RUNNING"}}})
+		assert.NoError(t, err)
+
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "PENDING", Value: 2, Description: "  ", Aliases: []string{"WAITING"}}})
+		assert.ErrorContains(t, err, "enum PENDING must have a description")
+
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "PENDING", Value: 2, Description: "Pending"}})
+		assert.ErrorContains(t, err, "enum PENDING has 0 aliases, at least 1 required")
+
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "PENDING", Value: 2, Description: "Pending", Aliases: []string{"A", "B", "C"}}})
+		assert.ErrorContains(t, err, "enum PENDING has 3 aliases, at most 2 allowed")
+	})
+
+	t.Run("alias collisions", func(t *testing.T) {
+		document := `[
+			{"name": "ACTIVE", "value": 1, "aliases": ["RUNNING", "LIVE"]},
+			{"name": "STARTED", "value": 2, "aliases": ["running", "BEGUN"]}
+		]`
+		newLoader := func(handling DuplicateHandling) *DynamicEnumLoader {
+			return NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip, AliasHandling: handling})
+		}
+
+		loader := newLoader(DuplicateError)
+		err := loader.LoadFromReader(strings.NewReader(document))
+		assert.ErrorContains(t, err, "definition #1 (name=STARTED): duplicate alias found: alias=running, used by ACTIVE")
+
+		loader = newLoader(DuplicateSkip)
+		assert.NoError(t, loader.LoadFromReader(strings.NewReader(document)))
+		enum, _ := loader.GetEnumSet().GetByName("RUNNING")
+		assert.Equal(t, "ACTIVE", enum.String(), "the first enum should keep the alias")
+		started, _ := loader.GetEnumSet().GetByName("STARTED")
+		assert.Equal(t, []string{"BEGUN"}, started.Aliases())
+
+		loader = newLoader(DuplicateOverride)
+		assert.NoError(t, loader.LoadFromReader(strings.NewReader(document)))
+		enum, _ = loader.GetEnumSet().GetByName("RUNNING")
+		assert.Equal(t, "STARTED", enum.String(), "the last enum should take the alias")
+		active, _ := loader.GetEnumSet().GetByName("ACTIVE")
+		assert.Equal(t, []string{"LIVE"}, active.Aliases())
+	})
+
+	t.Run("alias equal to another name", func(t *testing.T) {
+		definitions := []EnumDefinition{
+			{Name: "ACTIVE", Value: 1},
+			{Name: "STARTED", Value: 2, Aliases: []string{"Active", "BEGUN"}},
+		}
+
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip})
+		err := loader.LoadFromSlice(definitions)
+		assert.ErrorContains(t, err, "alias=Active is the name of another enum")
+
+		loader = NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip, AliasHandling: DuplicateOverride})
+		assert.NoError(t, loader.LoadFromSlice(definitions))
+		started, _ := loader.GetEnumSet().GetByName("STARTED")
+		assert.Equal(t, []string{"BEGUN"}, started.Aliases(), "names should take precedence over aliases")
+	})
+
+	t.Run("custom validators", func(t *testing.T) {
+		errNoDescription := errors.New("description is required")
+		options := DefaultValidationOptions()
+		options.DuplicateHandling = DuplicateSkip
+		options.Validators = []func(EnumDefinition) error{
+			func(def EnumDefinition) error {
+				if def.Description == "" {
+					return errNoDescription
+				}
+				return nil
+			},
+			func(def EnumDefinition) error {
+				if strings.HasPrefix(def.Name, "INTERNAL_") {
+					return fmt.Errorf("prefix INTERNAL_ is reserved")
+				}
+				return nil
+			},
+		}
+
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromSlice([]EnumDefinition{{Name: "ACTIVE", Value: 1, Description: "Active"}})
+		assert.NoError(t, err)
+
+		err = loader.LoadFromSlice([]EnumDefinition{{Name: "PENDING", Value: 2}})
+		assert.ErrorIs(t, err, errNoDescription)
+		assert.EqualError(t, err, "definition #0 (name=PENDING): invalid enum definition: enum PENDING: description is required")
+
+		err = loader.LoadFromReader(strings.NewReader(`[{"name": "INTERNAL_DEBUG", "value": 3, "description": "Debug"}]`))
+		assert.ErrorContains(t, err, "prefix INTERNAL_ is reserved")
+		assert.Equal(t, []string{"ACTIVE"}, loader.GetEnumSet().Names())
+	})
+}
+
+func TestLoadFromStream(t *testing.T) {
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+
+	t.Run("large array", func(t *testing.T) {
+		reader, writer := io.Pipe()
+		go func() {
+			writer.Write([]byte("["))
+			for i := 0; i < 10000; i++ {
+				if i > 0 {
+					writer.Write([]byte(","))
+				}
+				fmt.Fprintf(writer, `{"name": "E%d", "value": %d, "aliases": ["A%d"]}`, i, i, i)
+			}
+			writer.Write([]byte("]"))
+			writer.Close()
+		}()
+
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromStream(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, 10000, len(loader.GetEnumSet().Values()))
+		enum, exists := loader.GetEnumSet().GetByName("A9999")
+		assert.True(t, exists)
+		assert.Equal(t, 9999, enum.Value())
+	})
+
+	t.Run("invalid entry", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromStream(strings.NewReader(`[{"name": "A", "value": 1}, {"name": "", "value": 2}]`))
+		assert.ErrorContains(t, err, "definition #1")
+		_, exists := loader.GetEnumSet().GetByName("A")
+		assert.True(t, exists, "entries before the invalid one should stay registered")
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		for _, input := range []string{``, `{"name": "A"}`, `[{"name": "A", "value": 1}`, `[{"name": 1}]`} {
+			loader := NewDynamicEnumLoader(options)
+			assert.Error(t, loader.LoadFromStream(strings.NewReader(input)), input)
+		}
+	})
+
+	t.Run("migrations", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options).AddMigration("OLD", "NEW")
+		err := loader.LoadFromStream(strings.NewReader(`[{"name": "OLD", "value": 1}]`))
+		assert.NoError(t, err)
+		enum, err := loader.GetEnumSet().Parse("OLD")
+		assert.NoError(t, err)
+		assert.Equal(t, "NEW", enum.String())
+	})
+}
+
+func BenchmarkLoadFromSliceOverride(b *testing.B) {
+	for _, size := range []int{100, 1000, 10000} {
+		definitions := make([]EnumDefinition, 0, 2*size)
+		for i := 0; i < size; i++ {
+			definitions = append(definitions, EnumDefinition{Name: fmt.Sprintf("E%d", i), Value: i})
+		}
+		for i := 0; i < size; i++ {
+			definitions = append(definitions, EnumDefinition{Name: fmt.Sprintf("E%d", i), Value: size + i})
+		}
+
+		b.Run(fmt.Sprint(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateOverride})
+				if err := loader.LoadFromSlice(definitions); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
 }
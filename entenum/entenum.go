@@ -0,0 +1,59 @@
+// Package entenum derives ent enum fields from goenum sets, so ent schemas no longer
+// duplicate the value lists of their enums.
+//
+// It depends on ent only through the Builder interface, which the builder returned by
+// ent's field.Enum satisfies:
+//
+//	func (Order) Fields() []ent.Field {
+//		return []ent.Field{
+//			entenum.Field(field.Enum("status"), StatusEnumSet),
+//		}
+//	}
+package entenum
+
+import (
+	"fmt"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// Builder is the part of ent's enum field builder Field configures
+type Builder[B any] interface {
+	Values(values ...string) B
+	Default(value string) B
+}
+
+// Field sets the values of an ent enum field to the names of the enums in set, in
+// registration order, and its default to the default of the set, if any
+func Field[B Builder[B], T goenum.Enum](builder B, set *goenum.EnumSet[T]) B {
+	builder = builder.Values(Values(set)...)
+	if enum, exists := set.Default(); exists {
+		builder = builder.Default(enum.String())
+	}
+	return builder
+}
+
+// Values returns the names of the enums in set in registration order, as stored by ent
+func Values[T goenum.Enum](set *goenum.EnumSet[T]) []string {
+	enums := set.Values()
+	values := make([]string, 0, len(enums))
+	for _, enum := range enums {
+		values = append(values, enum.String())
+	}
+	return values
+}
+
+// Validator returns a function rejecting names not registered in set, for the Validate
+// method of ent string fields or for hooks checking mutations before they are written
+func Validator[T goenum.Enum](set *goenum.EnumSet[T]) func(string) error {
+	return func(name string) error {
+		enum, err := set.GetByNameE(name)
+		if err != nil {
+			return err
+		}
+		if enum.String() != name {
+			return fmt.Errorf("%s is an alias of %s, store the name instead", name, enum.String())
+		}
+		return nil
+	}
+}
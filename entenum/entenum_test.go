@@ -0,0 +1,51 @@
+package entenum
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+// enumBuilder records what Field configures, like ent's field.Enum builder
+type enumBuilder struct {
+	values       []string
+	defaultValue string
+}
+
+func (b *enumBuilder) Values(values ...string) *enumBuilder {
+	b.values = append(b.values, values...)
+	return b
+}
+
+func (b *enumBuilder) Default(value string) *enumBuilder {
+	b.defaultValue = value
+	return b
+}
+
+func newStatusSet() *goenum.EnumSet[*goenum.EnumBase] {
+	set := goenum.NewEnumSet[*goenum.EnumBase]()
+	pending := goenum.NewEnumBase(1, "PENDING", "Pending", "new")
+	set.Register(pending)
+	set.Register(goenum.NewEnumBase(2, "ACTIVE", "Active"))
+	set.Register(goenum.NewEnumBase(3, "CLOSED", "Closed"))
+	set.SetDefault(pending)
+	return set
+}
+
+func TestField(t *testing.T) {
+	builder := Field(&enumBuilder{}, newStatusSet())
+	assert.Equal(t, []string{"PENDING", "ACTIVE", "CLOSED"}, builder.values)
+	assert.Equal(t, "PENDING", builder.defaultValue)
+
+	builder = Field(&enumBuilder{}, goenum.NewEnumSet[*goenum.EnumBase]())
+	assert.Empty(t, builder.defaultValue, "sets without a default should leave it unset")
+}
+
+func TestValidator(t *testing.T) {
+	validate := Validator(newStatusSet())
+	assert.NoError(t, validate("ACTIVE"))
+	assert.True(t, errors.Is(validate("ARCHIVED"), goenum.ErrUnknownEnum))
+	assert.EqualError(t, validate("new"), "new is an alias of PENDING, store the name instead")
+}
@@ -47,6 +47,21 @@ const (
 // EnumJSONConfig holds configuration for JSON serialization
 type EnumJSONConfig struct {
 	Format JSONFormat
+	// NameTransform changes the casing of names on marshal; on unmarshal any casing is accepted
+	NameTransform JSONNameTransform
+	// Strict makes unmarshaling fail with an *UnknownEnumError for names or values
+	// not registered in the enum's set
+	Strict bool
+	// Set, when not nil, is the set Strict unmarshaling resolves against instead of the
+	// set the enum was first registered in, so fresh enums can be decoded strictly
+	Set AnyEnumSet
+	// MarshalFunc, when set, replaces Format and NameTransform on marshal
+	MarshalFunc MarshalFunc
+	// UnmarshalFunc, when set, replaces Format and NameTransform on unmarshal
+	UnmarshalFunc UnmarshalFunc
+	// UnknownAsNull marshals the unknown sentinel of the enum's set as JSON null, in every
+	// format, instead of like other registered enums
+	UnknownAsNull bool
 }
 
 // DefaultJSONConfig returns the default JSON configuration
@@ -58,11 +73,21 @@ func DefaultJSONConfig() *EnumJSONConfig {
 
 // EnumBase provides a basic implementation of Enum interface
 type EnumBase struct {
-	value       interface{}
-	name        string
-	description string
-	aliases     []string
-	jsonConfig  *EnumJSONConfig
+	value        interface{}
+	name         string
+	description  string
+	aliases      []string
+	group        string
+	displayName  string
+	meta         map[string]interface{}
+	tags         []string
+	valueRange   *valueRange
+	externalCode interface{}
+	jsonConfig   *EnumJSONConfig
+	owner        enumOwner
+	held         bool // registered in a set, so decoding must not change it
+	deprecated   []string
+	retired      bool
 }
 
 // String returns the string representation of the enum
@@ -118,51 +143,192 @@ func (e *EnumBase) Aliases() []string {
 // NewEnumSet creates a new EnumSet instance
 func NewEnumSet[T Enum]() *EnumSet[T] {
 	return &EnumSet[T]{
-		values:  make(map[string]T),
-		byValue: make(map[interface{}]T),
+		values:      make(map[string]T),
+		positions:   make(map[string]int),
+		byValue:     make(map[interface{}]T),
+		deprecation: &deprecationTracker{},
 	}
 }
 
-// EnumSet represents a collection of enum values
+// EnumSet represents a collection of enum values. Everything that enumerates a set, from
+// Values and Names to queries, options and exports, visits the enums in registration order.
 type EnumSet[T Enum] struct {
-	values  map[string]T
-	byValue map[interface{}]T
+	values             map[string]T
+	order              []string       // names in registration order, including unregistered ones
+	positions          map[string]int // index in order of each registered name
+	unregistered       int            // entries of order left by Unregister until compacted
+	byValue            map[interface{}]T
+	aliasIndex         map[string][]T // enums with each alias, in registration order
+	byExternalCode     map[interface{}]T
+	caseSensitive      bool
+	namespace          string
+	indexes            map[string]*enumIndex[T]
+	validators         []func(T) error
+	ranges             []rangeEntry[T]
+	metrics            LookupMetrics
+	jsonConfig         *EnumJSONConfig
+	defaultEnum        T
+	hasDefault         bool
+	unknown            T
+	hasUnknown         bool
+	migrations         *enumMigrations
+	deprecation        *deprecationTracker
+	aliasPolicy        AliasCollisionPolicy
+	aliasCollisionHook func(AliasCollision)
+	panicFree          bool
+	errs               []error
+}
+
+// Register adds an enum value to the set and returns the EnumSet for chaining.
+// It panics if the enum is a duplicate or fails validation, unless in panic-free mode.
+func (es *EnumSet[T]) Register(enum T) *EnumSet[T] {
+	if err := es.TryRegister(enum); err != nil {
+		es.fail(err)
+	}
+	return es
 }
 
-// Register adds an enum value to the set and returns the EnumSet for chaining
-func (es *EnumSet[T]) Register(enum T) *EnumSet[T] {
+// TryRegister adds an enum value to the set, returning an error instead of panicking
+func (es *EnumSet[T]) TryRegister(enum T) error {
+	return es.registerOwnedBy(enum, es)
+}
+
+// registerOwnedBy registers enum like TryRegister, binding it to owner once it passes the
+// checks, so a rejected enum stays unbound
+func (es *EnumSet[T]) registerOwnedBy(enum T, owner enumOwner) error {
+	if err := es.checkRegister(enum); err != nil {
+		return err
+	}
+	es.applyRegister(enum, owner)
+	return nil
+}
+
+// applyRegister binds a checked enum to owner and adds it to the set, reporting its alias
+// collisions under AliasCollisionWarn
+func (es *EnumSet[T]) applyRegister(enum T, owner enumOwner) {
+	var collisions []AliasCollision
+	if es.aliasPolicy == AliasCollisionWarn {
+		collisions = es.aliasCollisions(enum)
+	}
+
+	if owned, ok := any(enum).(ownedEnum); ok {
+		owned.bindOwner(owner)
+	}
+	es.add(enum)
+	es.reportAliasCollisions(enum, collisions)
+}
+
+// add adds a checked enum to the set and its indexes
+func (es *EnumSet[T]) add(enum T) {
+	es.values[enum.String()] = enum
+	es.positions[enum.String()] = len(es.order)
+	es.order = append(es.order, enum.String())
+	es.byValue[enum.Value()] = enum
+	es.indexAlias(enum)
+	es.addExternalCode(enum)
+	for _, index := range es.indexes {
+		index.add(enum)
+	}
+	es.addRange(enum)
+}
+
+// Unregister removes the enum registered under name from the set and its indexes,
+// clearing the default and unknown sentinel if it was either, and returns it
+func (es *EnumSet[T]) Unregister(name string) (T, bool) {
+	enum, exists := es.values[name]
+	if !exists {
+		var zero T
+		return zero, false
+	}
+
+	delete(es.values, name)
+	delete(es.positions, name)
+	es.unregistered++
+	if es.unregistered > len(es.order)/2 {
+		es.compact()
+	}
+	delete(es.byValue, enum.Value())
+	es.unindexAlias(enum)
+	if code, ok := externalCodeOf(enum); ok {
+		delete(es.byExternalCode, code)
+	}
+	for _, index := range es.indexes {
+		index.remove(enum)
+	}
+	es.removeRange(enum)
+
+	var zero T
+	if es.hasDefault && es.defaultEnum.String() == name {
+		es.defaultEnum, es.hasDefault = zero, false
+	}
+	if es.hasUnknown && es.unknown.String() == name {
+		es.unknown, es.hasUnknown = zero, false
+	}
+	return enum, true
+}
+
+// checkRegister reports why an enum cannot be registered, if at all
+func (es *EnumSet[T]) checkRegister(enum T) error {
 	name := enum.String()
 	value := enum.Value()
 
 	// Check for duplicate name
 	if _, exists := es.values[name]; exists {
-		panic(fmt.Sprintf("duplicate enum name: %s", name))
+		return fmt.Errorf("duplicate enum name: %s", name)
 	}
 
 	// Check for duplicate value
 	if _, exists := es.byValue[value]; exists {
-		panic(fmt.Sprintf("duplicate enum value: %v", value))
+		return fmt.Errorf("duplicate enum value: %v", value)
 	}
 
-	es.values[name] = enum
-	es.byValue[value] = enum
-	return es
+	// Check for duplicate external codes
+	if err := es.checkExternalCode(enum); err != nil {
+		return err
+	}
+
+	// Check for duplicate secondary index keys
+	for indexName, index := range es.indexes {
+		if err := index.check(indexName, enum); err != nil {
+			return err
+		}
+	}
+
+	// Check for invalid or overlapping ranges
+	if err := es.checkRange(enum); err != nil {
+		return err
+	}
+
+	// Check for names and aliases resolving to other enums
+	if es.aliasPolicy == AliasCollisionError {
+		if err := es.checkAliases(enum); err != nil {
+			return err
+		}
+	}
+
+	return es.runValidators(enum)
 }
 
-// GetByName retrieves an enum by its string name
+// GetByName retrieves an enum by its string name or alias, ignoring case unless the set
+// is case-sensitive. Lookups of names and aliases up to 64 ASCII bytes do not allocate.
 func (es *EnumSet[T]) GetByName(name string) (T, bool) {
-	enum, exists := es.values[strings.ToUpper(name)]
+	enum, exists := es.lookupName(name)
 	if exists {
+		es.observe(LookupNameHit, enum.String())
 		return enum, true
 	}
 
 	// Check aliases
-	for _, e := range es.values {
-		if e.HasAlias(name) {
-			return e, true
+	if e, exists := es.lookupAlias(name); exists {
+		if es.trackDeprecatedAlias(e, name) {
+			es.observe(LookupDeprecatedAliasHit, name)
+		} else {
+			es.observe(LookupAliasHit, name)
 		}
+		return e, true
 	}
 
+	es.observe(LookupNameMiss, name)
 	var zero T
 	return zero, false
 }
@@ -170,14 +336,41 @@ func (es *EnumSet[T]) GetByName(name string) (T, bool) {
 // GetByValue retrieves an enum by its value
 func (es *EnumSet[T]) GetByValue(value interface{}) (T, bool) {
 	enum, exists := es.byValue[value]
+	if exists {
+		es.observe(LookupValueHit, enum.String())
+	} else if es.metrics != nil {
+		es.observe(LookupValueMiss, fmt.Sprint(value))
+	}
 	return enum, exists
 }
 
-// Values returns all registered enum values
+// registeredAt reports whether the name at index i of order is registered there, rather
+// than left behind by Unregister
+func (es *EnumSet[T]) registeredAt(i int) bool {
+	position, exists := es.positions[es.order[i]]
+	return exists && position == i
+}
+
+// compact drops the names left behind by Unregister from order
+func (es *EnumSet[T]) compact() {
+	order := make([]string, 0, len(es.values))
+	for i, name := range es.order {
+		if es.registeredAt(i) {
+			es.positions[name] = len(order)
+			order = append(order, name)
+		}
+	}
+	es.order = order
+	es.unregistered = 0
+}
+
+// Values returns all registered enum values in registration order
 func (es *EnumSet[T]) Values() []T {
 	result := make([]T, 0, len(es.values))
-	for _, v := range es.values {
-		result = append(result, v)
+	for i, name := range es.order {
+		if es.registeredAt(i) {
+			result = append(result, es.values[name])
+		}
 	}
 	return result
 }
@@ -188,7 +381,40 @@ func (es *EnumSet[T]) Contains(enum T) bool {
 	return exists
 }
 
-// SetJSONConfig sets the JSON serialization configuration
+// ContainsName checks if a name or alias resolves in the set, like GetByName
+func (es *EnumSet[T]) ContainsName(name string) bool {
+	_, exists := es.GetByName(name)
+	return exists
+}
+
+// ContainsValue checks if an enum with the given value exists in the set
+func (es *EnumSet[T]) ContainsValue(value interface{}) bool {
+	_, exists := es.GetByValue(value)
+	return exists
+}
+
+// ContainsAll checks if every name or alias resolves in the set; it is true for no names
+func (es *EnumSet[T]) ContainsAll(names ...string) bool {
+	for _, name := range names {
+		if !es.ContainsName(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny checks if at least one name or alias resolves in the set
+func (es *EnumSet[T]) ContainsAny(names ...string) bool {
+	for _, name := range names {
+		if es.ContainsName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetJSONConfig sets the JSON serialization configuration of this enum only,
+// overriding the configuration of its set. Pass nil to fall back to the set configuration.
 func (e *EnumBase) SetJSONConfig(config *EnumJSONConfig) {
 	if e == nil {
 		return
@@ -196,21 +422,43 @@ func (e *EnumBase) SetJSONConfig(config *EnumJSONConfig) {
 	e.jsonConfig = config
 }
 
-// GetJSONConfig returns the current JSON configuration
+// GetJSONConfig returns the current JSON configuration: the enum's own configuration if set,
+// otherwise the configuration of the set it was first registered in, otherwise the default
 func (e *EnumBase) GetJSONConfig() *EnumJSONConfig {
-	if e == nil || e.jsonConfig == nil {
+	if e == nil {
 		return DefaultJSONConfig()
 	}
-	return e.jsonConfig
+	if e.jsonConfig != nil {
+		return e.jsonConfig
+	}
+	if e.owner != nil {
+		if config := e.owner.ownerJSONConfig(); config != nil {
+			return config
+		}
+	}
+	return DefaultJSONConfig()
 }
 
 // MarshalJSON implements JSON marshaling for enum
 func (e *EnumBase) MarshalJSON() ([]byte, error) {
+	return e.MarshalJSONWithConfig(e.GetJSONConfig())
+}
+
+// MarshalJSONWithConfig marshals the enum using the given configuration for this call only
+func (e *EnumBase) MarshalJSONWithConfig(config *EnumJSONConfig) ([]byte, error) {
 	if e == nil {
 		return json.Marshal("")
 	}
+	if config == nil {
+		config = DefaultJSONConfig()
+	}
+	if config.UnknownAsNull && e.isUnknownSentinel() {
+		return []byte("null"), nil
+	}
+	if config.MarshalFunc != nil {
+		return config.MarshalFunc(e)
+	}
 
-	config := e.GetJSONConfig()
 	switch config.Format {
 	case JSONFormatValue:
 		return json.Marshal(e.Value())
@@ -220,25 +468,44 @@ func (e *EnumBase) MarshalJSON() ([]byte, error) {
 			Value       interface{} `json:"value"`
 			Description string      `json:"description"`
 			Aliases     []string    `json:"aliases,omitempty"`
+			Group       string      `json:"group,omitempty"`
+			DisplayName string      `json:"displayName,omitempty"`
+			Tags        []string    `json:"tags,omitempty"`
 		}
 		return json.Marshal(FullEnum{
-			Name:        e.name,
+			Name:        config.NameTransform.apply(e.name),
 			Value:       e.value,
 			Description: e.description,
 			Aliases:     e.aliases,
+			Group:       e.group,
+			DisplayName: e.displayName,
+			Tags:        e.tags,
 		})
 	default: // JSONFormatName
-		return json.Marshal(e.String())
+		return json.Marshal(config.NameTransform.apply(e.String()))
 	}
 }
 
 // UnmarshalJSON implements JSON unmarshaling for enum
 func (e *EnumBase) UnmarshalJSON(data []byte) error {
+	return e.UnmarshalJSONWithConfig(data, e.GetJSONConfig())
+}
+
+// UnmarshalJSONWithConfig unmarshals the enum using the given configuration for this call only
+func (e *EnumBase) UnmarshalJSONWithConfig(data []byte, config *EnumJSONConfig) error {
 	if e == nil {
 		return fmt.Errorf("cannot unmarshal into nil EnumBase")
 	}
+	if config == nil {
+		config = DefaultJSONConfig()
+	}
+	if config.Strict {
+		return e.unmarshalStrict(data, config)
+	}
+	if config.UnmarshalFunc != nil {
+		return e.unmarshalHook(data, config.UnmarshalFunc)
+	}
 
-	config := e.GetJSONConfig()
 	switch config.Format {
 	case JSONFormatValue:
 		var value interface{}
@@ -258,12 +525,15 @@ func (e *EnumBase) UnmarshalJSON(data []byte) error {
 			Value       interface{} `json:"value"`
 			Description string      `json:"description"`
 			Aliases     []string    `json:"aliases,omitempty"`
+			Group       string      `json:"group,omitempty"`
+			DisplayName string      `json:"displayName,omitempty"`
+			Tags        []string    `json:"tags,omitempty"`
 		}
 		var full FullEnum
 		if err := json.Unmarshal(data, &full); err != nil {
 			return err
 		}
-		e.name = full.Name
+		e.name = config.NameTransform.normalize(full.Name)
 		// Convert float64 to int if necessary
 		if f, ok := full.Value.(float64); ok {
 			e.value = int(f)
@@ -272,13 +542,16 @@ func (e *EnumBase) UnmarshalJSON(data []byte) error {
 		}
 		e.description = full.Description
 		e.aliases = full.Aliases
+		e.group = full.Group
+		e.displayName = full.DisplayName
+		e.tags = full.Tags
 		return nil
 	default: // JSONFormatName
 		var name string
 		if err := json.Unmarshal(data, &name); err != nil {
 			return err
 		}
-		e.name = name
+		e.name = config.NameTransform.normalize(name)
 		return nil
 	}
 }
@@ -290,15 +563,16 @@ func NewEnumBase(value interface{}, name string, description string, aliases ...
 		name:        name,
 		description: description,
 		aliases:     aliases,
-		jsonConfig:  DefaultJSONConfig(),
 	}
 }
 
-// Names returns a slice of all enum names in the set
+// Names returns the names of all enums in the set in registration order
 func (es *EnumSet[T]) Names() []string {
 	names := make([]string, 0, len(es.values))
-	for name := range es.values {
-		names = append(names, name)
+	for i, name := range es.order {
+		if es.registeredAt(i) {
+			names = append(names, name)
+		}
 	}
 	return names
 }
@@ -312,11 +586,14 @@ func (es *EnumSet[T]) Map() map[string]interface{} {
 	return result
 }
 
-// Filter returns a slice of enums that satisfy the given predicate
+// Filter returns a slice of enums that satisfy the given predicate, in registration order
 func (es *EnumSet[T]) Filter(predicate func(T) bool) []T {
 	result := make([]T, 0)
-	for _, enum := range es.values {
-		if predicate(enum) {
+	for i, name := range es.order {
+		if !es.registeredAt(i) {
+			continue
+		}
+		if enum := es.values[name]; predicate(enum) {
 			result = append(result, enum)
 		}
 	}
@@ -326,7 +603,9 @@ func (es *EnumSet[T]) Filter(predicate func(T) bool) []T {
 // CompositeEnumBase provides a basic implementation of CompositeEnum interface
 type CompositeEnumBase struct {
 	*EnumBase
-	flags uint64
+	flags       uint64
+	registry    *CompositeEnumSet
+	combination bool
 }
 
 // NewCompositeEnumBase creates a new CompositeEnumBase with the given parameters
@@ -358,6 +637,7 @@ func (e *CompositeEnumBase) Or(other CompositeEnum) CompositeEnum {
 	return &CompositeEnumBase{
 		EnumBase: NewEnumBase(e.flags|otherBase.flags, e.name+"|"+other.String(), e.description),
 		flags:    e.flags | otherBase.flags,
+		registry: e.sharedRegistry(otherBase),
 	}
 }
 
@@ -373,6 +653,7 @@ func (e *CompositeEnumBase) And(other CompositeEnum) CompositeEnum {
 	return &CompositeEnumBase{
 		EnumBase: NewEnumBase(e.flags&otherBase.flags, e.name+"&"+other.String(), e.description),
 		flags:    e.flags & otherBase.flags,
+		registry: e.sharedRegistry(otherBase),
 	}
 }
 
@@ -388,6 +669,7 @@ func (e *CompositeEnumBase) Xor(other CompositeEnum) CompositeEnum {
 	return &CompositeEnumBase{
 		EnumBase: NewEnumBase(e.flags^otherBase.flags, e.name+"^"+other.String(), e.description),
 		flags:    e.flags ^ otherBase.flags,
+		registry: e.sharedRegistry(otherBase),
 	}
 }
 
@@ -399,6 +681,7 @@ func (e *CompositeEnumBase) Not() CompositeEnum {
 	return &CompositeEnumBase{
 		EnumBase: NewEnumBase(^e.flags, "~"+e.name, e.description),
 		flags:    ^e.flags,
+		registry: e.registry,
 	}
 }
 
@@ -453,5 +736,6 @@ func (e *CompositeEnumBase) RemoveFlag(flag CompositeEnum) CompositeEnum {
 	return &CompositeEnumBase{
 		EnumBase: NewEnumBase(newFlags, e.name+"-"+flag.String(), e.description),
 		flags:    newFlags,
+		registry: e.sharedRegistry(flagBase),
 	}
 }
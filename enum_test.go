@@ -2,6 +2,7 @@ package goenum
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -125,6 +126,50 @@ func TestEnumSetRegistration(t *testing.T) {
 		}, "Register() should panic on duplicate value")
 	})
 
+	t.Run("unregister", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]()
+		set.Register(TestEnumA).Register(TestEnumB).SetDefault(TestEnumA)
+
+		enum, removed := set.Unregister("A")
+		assert.True(t, removed, "Unregister() should report the removed enum")
+		assert.Equal(t, TestEnumA, enum)
+		assert.False(t, set.Contains(TestEnumA), "Unregister() should remove the name")
+		_, exists := set.GetByValue(1)
+		assert.False(t, exists, "Unregister() should remove the value")
+		_, hasDefault := set.Default()
+		assert.False(t, hasDefault, "Unregister() should clear the default")
+
+		_, removed = set.Unregister("A")
+		assert.False(t, removed, "Unregister() should ignore unknown names")
+		assert.NotPanics(t, func() {
+			set.Register(TestEnum{NewEnumBase(1, "A", "Replacement")})
+		}, "Register() should accept the name and value again")
+	})
+
+	t.Run("unregister keeps registration order", func(t *testing.T) {
+		namesOf := func(enums []TestEnum) []string {
+			names := make([]string, 0, len(enums))
+			for _, enum := range enums {
+				names = append(names, enum.String())
+			}
+			return names
+		}
+		set := NewEnumSet[TestEnum]()
+		for i := 0; i < 6; i++ {
+			set.Register(TestEnum{NewEnumBase(i, fmt.Sprintf("E%d", i), "")})
+		}
+		set.Unregister("E1")
+		set.Register(TestEnum{NewEnumBase(1, "E1", "Registered again")})
+		set.Unregister("E3")
+		assert.Equal(t, []string{"E0", "E2", "E4", "E5", "E1"}, namesOf(set.Values()))
+
+		for _, name := range []string{"E0", "E2", "E4"} {
+			set.Unregister(name)
+		}
+		assert.Equal(t, []string{"E5", "E1"}, namesOf(set.Values()), "compaction should keep the order")
+		assert.Equal(t, []string{"E1"}, namesOf(set.Filter(func(e TestEnum) bool { return e.Value() == 1 })))
+	})
+
 	t.Run("chainable registration", func(t *testing.T) {
 		set := NewEnumSet[TestEnum]()
 		result := set.Register(TestEnumA).Register(TestEnumB)
@@ -142,6 +187,7 @@ func TestJSONSerializationFormats(t *testing.T) {
 	})
 
 	t.Run("value format serialization", func(t *testing.T) {
+		t.Cleanup(func() { TestEnumA.SetJSONConfig(nil) })
 		TestEnumA.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
 		data, err := json.Marshal(TestEnumA)
 		assert.NoError(t, err, "Marshal() should not return error")
@@ -149,6 +195,7 @@ func TestJSONSerializationFormats(t *testing.T) {
 	})
 
 	t.Run("full format serialization", func(t *testing.T) {
+		t.Cleanup(func() { TestEnumA.SetJSONConfig(nil) })
 		TestEnumA.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatFull})
 		data, err := json.Marshal(TestEnumA)
 		assert.NoError(t, err, "Marshal() should not return error")
@@ -245,6 +292,25 @@ func TestEnumSetEdgeCases(t *testing.T) {
 	})
 }
 
+func TestEnumSetMembership(t *testing.T) {
+	t.Run("ContainsName() and ContainsValue()", func(t *testing.T) {
+		assert.True(t, TestEnumSet.ContainsName("A"))
+		assert.True(t, TestEnumSet.ContainsName("charlie"), "ContainsName() should resolve aliases")
+		assert.False(t, TestEnumSet.ContainsName("D"))
+		assert.True(t, TestEnumSet.ContainsValue(2))
+		assert.False(t, TestEnumSet.ContainsValue("2"), "ContainsValue() should not convert types")
+	})
+
+	t.Run("ContainsAll() and ContainsAny()", func(t *testing.T) {
+		assert.True(t, TestEnumSet.ContainsAll("A", "BETA", "THIRD"))
+		assert.False(t, TestEnumSet.ContainsAll("A", "D"))
+		assert.True(t, TestEnumSet.ContainsAll(), "ContainsAll() should hold for no names")
+		assert.True(t, TestEnumSet.ContainsAny("D", "B"))
+		assert.False(t, TestEnumSet.ContainsAny("D", "E"))
+		assert.False(t, TestEnumSet.ContainsAny())
+	})
+}
+
 func TestEnumDescription(t *testing.T) {
 	t.Run("description operations", func(t *testing.T) {
 		assert.Equal(t, "First enum", TestEnumA.Description(), "Description() should return first enum description")
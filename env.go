@@ -0,0 +1,108 @@
+package goenum
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// envFieldSeparator separates an enum name from a field suffix in environment variable names
+const envFieldSeparator = "__"
+
+// LoadFromEnv loads enum definitions from environment variables starting with prefix.
+// Each PREFIX_NAME=value variable defines the enum NAME; integer values are parsed as int.
+// Optional PREFIX_NAME__DESCRIPTION, PREFIX_NAME__ALIASES (comma-separated) and
+// PREFIX_NAME__GROUP variables complete the definition.
+func (l *DynamicEnumLoader) LoadFromEnv(prefix string) error {
+	prefix = strings.TrimSuffix(prefix, "_") + "_"
+	definitions := make(map[string]*EnumDefinition)
+	fields := make(map[string]map[string]string)
+
+	for _, entry := range os.Environ() {
+		key, value, _ := strings.Cut(entry, "=")
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name, field, hasField := strings.Cut(strings.TrimPrefix(key, prefix), envFieldSeparator)
+		if name == "" {
+			continue
+		}
+		if !hasField {
+			definitions[name] = &EnumDefinition{Name: name, Value: parseEnvValue(value)}
+			continue
+		}
+		if fields[name] == nil {
+			fields[name] = make(map[string]string)
+		}
+		fields[name][field] = value
+	}
+
+	// Check variables in sorted order, so the same environment always reports the same error
+	for _, name := range sortedKeys(fields) {
+		def, exists := definitions[name]
+		if !exists {
+			return fmt.Errorf("environment variables %s%s%s* have no value variable %s%s",
+				prefix, name, envFieldSeparator, prefix, name)
+		}
+		values := fields[name]
+		for _, field := range sortedKeys(values) {
+			value := values[field]
+			switch field {
+			case "DESCRIPTION":
+				def.Description = value
+			case "ALIASES":
+				def.Aliases = splitEnvList(value)
+			case "GROUP":
+				def.Group = value
+			default:
+				return fmt.Errorf("unknown enum field in environment variable %s%s%s%s", prefix, name, envFieldSeparator, field)
+			}
+		}
+	}
+
+	names := sortedKeys(definitions)
+	ordered := make([]EnumDefinition, 0, len(names))
+	for _, name := range names {
+		ordered = append(ordered, *definitions[name])
+	}
+	return l.LoadFromSlice(ordered)
+}
+
+// BindEnv parses the environment variable key into an enum of set by name, alias or value.
+// An unset or empty variable resolves to the set's default (see SetDefault) or fails
+// when the set has none.
+func BindEnv[T Enum](key string, set *EnumSet[T]) (T, error) {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		if def, ok := set.Default(); ok {
+			return def, nil
+		}
+		var zero T
+		return zero, fmt.Errorf("environment variable %s is not set", key)
+	}
+	enum, err := set.Parse(raw)
+	if err != nil {
+		return enum, fmt.Errorf("invalid environment variable %s: %w", key, err)
+	}
+	return enum, nil
+}
+
+// parseEnvValue converts an environment variable value to int when possible
+func parseEnvValue(value string) interface{} {
+	if i, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		return i
+	}
+	return value
+}
+
+// splitEnvList splits a comma-separated list, dropping empty items
+func splitEnvList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
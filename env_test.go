@@ -0,0 +1,97 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadFromEnv(t *testing.T) {
+	t.Run("loads definitions", func(t *testing.T) {
+		t.Setenv("ENVTEST_PENDING", "0")
+		t.Setenv("ENVTEST_ACTIVE", "1")
+		t.Setenv("ENVTEST_ACTIVE__DESCRIPTION", "Currently active")
+		t.Setenv("ENVTEST_ACTIVE__ALIASES", "RUNNING, LIVE,")
+		t.Setenv("ENVTEST_ACTIVE__GROUP", "open")
+		t.Setenv("ENVTEST_LABEL", "custom")
+		t.Setenv("OTHER_IGNORED", "9")
+
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip})
+		assert.NoError(t, loader.LoadFromEnv("ENVTEST"))
+
+		set := loader.GetEnumSet()
+		assert.ElementsMatch(t, []string{"PENDING", "ACTIVE", "LABEL"}, set.Names())
+
+		active, exists := set.GetByName("LIVE")
+		assert.True(t, exists, "aliases should be loaded")
+		assert.Equal(t, 1, active.Value(), "integer values should be parsed")
+		assert.Equal(t, "Currently active", active.Description())
+		assert.Equal(t, []string{"RUNNING", "LIVE"}, active.Aliases())
+		assert.Equal(t, "open", groupOf(active))
+
+		label, _ := set.GetByName("LABEL")
+		assert.Equal(t, "custom", label.Value(), "other values should stay strings")
+	})
+
+	t.Run("field without value", func(t *testing.T) {
+		t.Setenv("ENVTEST2_ACTIVE__DESCRIPTION", "Orphan")
+		err := NewDynamicEnumLoader(nil).LoadFromEnv("ENVTEST2_")
+		assert.EqualError(t, err, "environment variables ENVTEST2_ACTIVE__* have no value variable ENVTEST2_ACTIVE")
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Setenv("ENVTEST3_ACTIVE", "1")
+		t.Setenv("ENVTEST3_ACTIVE__COLOR", "red")
+		err := NewDynamicEnumLoader(nil).LoadFromEnv("ENVTEST3")
+		assert.EqualError(t, err, "unknown enum field in environment variable ENVTEST3_ACTIVE__COLOR")
+	})
+
+	t.Run("errors are reported in sorted order", func(t *testing.T) {
+		t.Setenv("ENVTEST4_ACTIVE", "1")
+		t.Setenv("ENVTEST4_ACTIVE__SIZE", "large")
+		t.Setenv("ENVTEST4_ACTIVE__COLOR", "red")
+		t.Setenv("ENVTEST4_PAUSED__DESCRIPTION", "Orphan")
+		t.Setenv("ENVTEST4_BLOCKED__DESCRIPTION", "Orphan")
+		for i := 0; i < 20; i++ {
+			err := NewDynamicEnumLoader(nil).LoadFromEnv("ENVTEST4")
+			assert.EqualError(t, err, "unknown enum field in environment variable ENVTEST4_ACTIVE__COLOR")
+		}
+	})
+}
+
+func TestBindEnv(t *testing.T) {
+	set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB)
+
+	t.Run("parses names, aliases and values", func(t *testing.T) {
+		t.Setenv("APP_MODE", " beta ")
+		enum, err := BindEnv("APP_MODE", set)
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumB, enum)
+
+		t.Setenv("APP_MODE", "1")
+		enum, err = BindEnv("APP_MODE", set)
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumA, enum)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Setenv("APP_MODE", "gamma")
+		_, err := BindEnv("APP_MODE", set)
+		assert.EqualError(t, err, `invalid environment variable APP_MODE: unknown enum "gamma" (allowed: A, B)`)
+		assert.ErrorIs(t, err, ErrUnknownEnum)
+	})
+
+	t.Run("unset without default", func(t *testing.T) {
+		t.Setenv("APP_MODE", "")
+		_, err := BindEnv("APP_MODE", set)
+		assert.EqualError(t, err, "environment variable APP_MODE is not set")
+	})
+
+	t.Run("unset with default", func(t *testing.T) {
+		t.Setenv("APP_MODE", "")
+		withDefault := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).SetDefault(TestEnumA)
+		enum, err := BindEnv("APP_MODE", withDefault)
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumA, enum, "BindEnv() should fall back to the set default")
+	})
+}
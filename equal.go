@@ -0,0 +1,60 @@
+package goenum
+
+import "reflect"
+
+// enumType is the reflect.Type of the Enum interface
+var enumType = reflect.TypeOf((*Enum)(nil)).Elem()
+
+// Equal reports whether a and b are the same enum by name and value. Values compare by
+// their JSON encoding, like Key and Hash, so 1, int64(1) and a float64 1 decoded from JSON
+// are equal. Nil enums, nil pointers and structs whose embedded *EnumBase is nil count as
+// the zero enum, equal only to another zero enum, so zero-value enums can be compared
+// without panicking.
+func Equal(a, b Enum) bool {
+	if isZeroEnum(a) || isZeroEnum(b) {
+		return isZeroEnum(a) && isZeroEnum(b)
+	}
+	return a.String() == b.String() && equalValues(a.Value(), b.Value())
+}
+
+// EqualByName reports whether a and b have the same name, treating zero enums like Equal
+func EqualByName(a, b Enum) bool {
+	if isZeroEnum(a) || isZeroEnum(b) {
+		return isZeroEnum(a) && isZeroEnum(b)
+	}
+	return a.String() == b.String()
+}
+
+// equalValues reports whether two enum values have the same JSON encoding
+func equalValues(a, b interface{}) bool {
+	return encodedValue(a) == encodedValue(b)
+}
+
+// isZeroEnum reports whether enum is nil, a nil pointer, or a struct embedding a nil enum
+func isZeroEnum(enum Enum) bool {
+	if enum == nil {
+		return true
+	}
+	return isZeroEnumValue(reflect.ValueOf(enum))
+}
+
+// isZeroEnumValue reports whether v is a nil pointer or interface, or a struct with an
+// embedded enum that is zero
+func isZeroEnumValue(v reflect.Value) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Anonymous && field.Type.Implements(enumType) && isZeroEnumValue(v.Field(i)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	type Status struct{ *EnumBase }
+	active := Status{NewEnumBase(1, "ACTIVE", "Active")}
+	loaded := NewEnumBase(float64(1), "ACTIVE", "Active, as loaded from JSON")
+	renumbered := NewEnumBase(2, "ACTIVE", "Active")
+
+	t.Run("Equal()", func(t *testing.T) {
+		assert.True(t, Equal(active, loaded), "whole float64 values should equal ints")
+		assert.True(t, Equal(active, NewEnumBase(int64(1), "ACTIVE", "")), "int and int64 values should be equal")
+		assert.False(t, Equal(active, renumbered))
+		assert.False(t, Equal(active, NewEnumBase(1, "ENABLED", "Active")))
+	})
+
+	t.Run("agrees with Key() and Hash()", func(t *testing.T) {
+		others := []*EnumBase{
+			loaded, renumbered,
+			NewEnumBase(int64(1), "ACTIVE", ""), NewEnumBase(uint8(1), "ACTIVE", ""), NewEnumBase("1", "ACTIVE", ""),
+		}
+		for _, other := range others {
+			equal := Equal(active, other)
+			assert.Equal(t, equal, active.Key() == other.Key(), "Equal() and Key() should agree for %v", other.Value())
+			assert.Equal(t, equal, active.Hash() == other.Hash(), "Equal() and Hash() should agree for %v", other.Value())
+		}
+	})
+
+	t.Run("EqualByName()", func(t *testing.T) {
+		assert.True(t, EqualByName(active, renumbered))
+		assert.False(t, EqualByName(active, NewEnumBase(1, "ENABLED", "Active")))
+	})
+
+	t.Run("zero enums", func(t *testing.T) {
+		var zero Status
+		var nilBase *EnumBase
+		var nilComposite *CompositeEnumBase
+		for _, equal := range []func(a, b Enum) bool{Equal, EqualByName} {
+			assert.True(t, equal(nil, nil))
+			assert.True(t, equal(zero, nilBase))
+			assert.True(t, equal(nilComposite, &CompositeEnumBase{}))
+			assert.False(t, equal(zero, active))
+			assert.False(t, equal(active, nil))
+			assert.False(t, equal(nilBase, loaded))
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package goenum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// EtcdSource reads a catalog from an etcd v3 key through the etcd JSON gateway
+// (/v3/kv/range and /v3/watch), watching it for live updates
+type EtcdSource struct {
+	// Endpoint is the etcd client URL, e.g. "http://127.0.0.1:2379"
+	Endpoint string
+	// Key is the key holding the catalog
+	Key string
+	// Client performs the requests; nil uses http.DefaultClient
+	Client *http.Client
+}
+
+// etcdKeyValue is a key-value pair in etcd gateway responses; bytes are base64-encoded
+type etcdKeyValue struct {
+	Key         []byte `json:"key"`
+	Value       []byte `json:"value"`
+	ModRevision uint64 `json:"mod_revision,string"`
+}
+
+// Get implements KVSource, returning the store revision of the read
+func (s *EtcdSource) Get(ctx context.Context) ([]byte, uint64, error) {
+	var resp struct {
+		Header struct {
+			Revision uint64 `json:"revision,string"`
+		} `json:"header"`
+		Kvs []etcdKeyValue `json:"kvs"`
+	}
+	body, err := s.post(ctx, "/v3/kv/range", map[string]interface{}{"key": []byte(s.Key)})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer body.Close()
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, 0, fmt.Errorf("invalid etcd response: %w", err)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, 0, fmt.Errorf("etcd key %s not found", s.Key)
+	}
+	return resp.Kvs[0].Value, resp.Header.Revision, nil
+}
+
+// Wait implements KVSource, watching the key from the revision after revision
+func (s *EtcdSource) Wait(ctx context.Context, revision uint64) ([]byte, uint64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	body, err := s.post(ctx, "/v3/watch", map[string]interface{}{
+		"create_request": map[string]interface{}{
+			"key":            []byte(s.Key),
+			"start_revision": fmt.Sprint(revision + 1),
+		},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	for {
+		var message struct {
+			Result struct {
+				Canceled        bool   `json:"canceled"`
+				CancelReason    string `json:"cancel_reason"`
+				CompactRevision uint64 `json:"compact_revision,string"`
+				Events          []struct {
+					Type string       `json:"type"`
+					Kv   etcdKeyValue `json:"kv"`
+				} `json:"events"`
+			} `json:"result"`
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := decoder.Decode(&message); err != nil {
+			return nil, 0, fmt.Errorf("etcd watch ended: %w", err)
+		}
+		if message.Error != nil {
+			return nil, 0, fmt.Errorf("etcd watch failed: %s", message.Error.Message)
+		}
+		if message.Result.Canceled {
+			if message.Result.CompactRevision > 0 {
+				return nil, 0, fmt.Errorf("etcd %w: revision %d was compacted (oldest is %d)",
+					ErrWatchCanceled, revision+1, message.Result.CompactRevision)
+			}
+			if message.Result.CancelReason != "" {
+				return nil, 0, fmt.Errorf("etcd %w: %s", ErrWatchCanceled, message.Result.CancelReason)
+			}
+			return nil, 0, fmt.Errorf("etcd %w", ErrWatchCanceled)
+		}
+		if len(message.Result.Events) == 0 {
+			continue
+		}
+		event := message.Result.Events[len(message.Result.Events)-1]
+		if event.Type == "DELETE" {
+			return nil, event.Kv.ModRevision, fmt.Errorf("etcd key %s was deleted", s.Key)
+		}
+		return event.Kv.Value, event.Kv.ModRevision, nil
+	}
+}
+
+// post sends a JSON request to the gateway and returns the response body
+func (s *EtcdSource) post(ctx context.Context, path string, payload interface{}) (io.ReadCloser, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(s.Endpoint, "/")+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("etcd request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("etcd returned %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,114 @@
+package goenum
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEtcd emulates the etcd v3 JSON gateway for one key
+type fakeEtcd struct {
+	key    string
+	value  string
+	events []string
+}
+
+func (f *fakeEtcd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]json.RawMessage
+	json.NewDecoder(r.Body).Decode(&body)
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	switch r.URL.Path {
+	case "/v3/kv/range":
+		if string(body["key"]) != fmt.Sprintf("%q", b64(f.key)) {
+			fmt.Fprint(w, `{"header": {"revision": "5"}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"header": {"revision": "5"}, "kvs": [{"key": %q, "value": %q, "mod_revision": "3"}]}`, b64(f.key), b64(f.value))
+	case "/v3/watch":
+		var create struct {
+			CreateRequest struct {
+				Key           []byte `json:"key"`
+				StartRevision string `json:"start_revision"`
+			} `json:"create_request"`
+		}
+		json.Unmarshal(mustMarshal(body), &create)
+		if string(create.CreateRequest.Key) != f.key || create.CreateRequest.StartRevision != "6" {
+			fmt.Fprint(w, `{"error": {"message": "unexpected watch request"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"result": {"header": {"revision": "5"}, "created": true}}`+"\n")
+		for _, event := range f.events {
+			fmt.Fprint(w, event+"\n")
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func mustMarshal(v interface{}) []byte {
+	data, _ := json.Marshal(v)
+	return data
+}
+
+func TestEtcdSource(t *testing.T) {
+	etcd := &fakeEtcd{key: "/enums/status", value: `[{"name": "A", "value": 1}]`}
+	server := httptest.NewServer(etcd)
+	defer server.Close()
+	source := &EtcdSource{Endpoint: server.URL, Key: "/enums/status"}
+
+	t.Run("Get()", func(t *testing.T) {
+		data, revision, err := source.Get(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"name": "A", "value": 1}]`, string(data), "values should be base64-decoded")
+		assert.Equal(t, uint64(5), revision)
+
+		_, _, err = (&EtcdSource{Endpoint: server.URL, Key: "/missing"}).Get(context.Background())
+		assert.EqualError(t, err, "etcd key /missing not found")
+	})
+
+	t.Run("Wait() returns the next change", func(t *testing.T) {
+		etcd.events = []string{fmt.Sprintf(`{"result": {"events": [{"kv": {"key": "eA==", "value": %q, "mod_revision": "8"}}]}}`,
+			base64.StdEncoding.EncodeToString([]byte(`[{"name": "B", "value": 2}]`)))}
+		data, revision, err := source.Wait(context.Background(), 5)
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"name": "B", "value": 2}]`, string(data))
+		assert.Equal(t, uint64(8), revision)
+	})
+
+	t.Run("Wait() reports deletions with their revision", func(t *testing.T) {
+		etcd.events = []string{`{"result": {"events": [{"type": "DELETE", "kv": {"key": "eA==", "mod_revision": "9"}}]}}`}
+		_, revision, err := source.Wait(context.Background(), 5)
+		assert.EqualError(t, err, "etcd key /enums/status was deleted")
+		assert.Equal(t, uint64(9), revision)
+	})
+
+	t.Run("Wait() reports compaction as a canceled watch", func(t *testing.T) {
+		etcd.events = []string{`{"result": {"canceled": true, "compact_revision": "7"}}`}
+		_, _, err := source.Wait(context.Background(), 5)
+		assert.ErrorIs(t, err, ErrWatchCanceled)
+		assert.EqualError(t, err, "etcd watch canceled: revision 6 was compacted (oldest is 7)")
+
+		etcd.events = []string{`{"result": {"canceled": true, "cancel_reason": "permission denied"}}`}
+		_, _, err = source.Wait(context.Background(), 5)
+		assert.EqualError(t, err, "etcd watch canceled: permission denied")
+	})
+
+	t.Run("Wait() errors", func(t *testing.T) {
+		_, _, err := source.Wait(context.Background(), 1)
+		assert.EqualError(t, err, "etcd watch failed: unexpected watch request")
+
+		etcd.events = nil
+		_, _, err = source.Wait(context.Background(), 5)
+		assert.ErrorContains(t, err, "etcd watch ended")
+
+		_, _, err = (&EtcdSource{Endpoint: server.URL + "/bad"}).Get(context.Background())
+		assert.ErrorContains(t, err, "etcd returned 404 Not Found")
+	})
+}
@@ -0,0 +1,67 @@
+package goenum
+
+import "fmt"
+
+// externalCodeEnum is implemented by enums that carry a code used by an external system
+type externalCodeEnum interface {
+	ExternalCode() interface{}
+}
+
+// WithExternalCode attaches the code an external system (e.g. a partner API) uses for the
+// enum and returns the EnumBase for chaining. Codes are looked up with GetByExternalCode
+// and do not affect names, aliases or values.
+func (e *EnumBase) WithExternalCode(code interface{}) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	e.externalCode = code
+	return e
+}
+
+// ExternalCode returns the code attached with WithExternalCode, or nil
+func (e *EnumBase) ExternalCode() interface{} {
+	if e == nil {
+		return nil
+	}
+	return e.externalCode
+}
+
+// externalCodeOf returns the external code of an enum, if any
+func externalCodeOf(enum Enum) (interface{}, bool) {
+	coded, ok := enum.(externalCodeEnum)
+	if !ok {
+		return nil, false
+	}
+	code := coded.ExternalCode()
+	return code, code != nil
+}
+
+// checkExternalCode returns an error if the enum's external code is already taken
+func (es *EnumSet[T]) checkExternalCode(enum T) error {
+	code, ok := externalCodeOf(enum)
+	if !ok {
+		return nil
+	}
+	if existing, exists := es.byExternalCode[code]; exists {
+		return fmt.Errorf("duplicate external code %v: used by %s", code, existing.String())
+	}
+	return nil
+}
+
+// addExternalCode indexes the enum by its external code
+func (es *EnumSet[T]) addExternalCode(enum T) {
+	code, ok := externalCodeOf(enum)
+	if !ok {
+		return
+	}
+	if es.byExternalCode == nil {
+		es.byExternalCode = make(map[interface{}]T)
+	}
+	es.byExternalCode[code] = enum
+}
+
+// GetByExternalCode retrieves an enum by the code attached with WithExternalCode
+func (es *EnumSet[T]) GetByExternalCode(code interface{}) (T, bool) {
+	enum, exists := es.byExternalCode[code]
+	return enum, exists
+}
@@ -0,0 +1,44 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalCodes(t *testing.T) {
+	pending := NewEnumBase(1, "PENDING", "Pending").WithExternalCode("P01")
+	active := NewEnumBase(2, "ACTIVE", "Active").WithExternalCode("A07")
+	set := NewEnumSet[*EnumBase]().Register(pending).Register(active).Register(NewEnumBase(3, "DRAFT", "Draft"))
+
+	t.Run("lookups", func(t *testing.T) {
+		assert.Equal(t, "A07", active.ExternalCode())
+		enum, exists := set.GetByExternalCode("P01")
+		assert.True(t, exists)
+		assert.Equal(t, pending, enum)
+
+		_, exists = set.GetByExternalCode("ACTIVE")
+		assert.False(t, exists, "names should not resolve as external codes")
+		_, exists = set.GetByName("A07")
+		assert.False(t, exists, "external codes should not resolve as names")
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		err := set.TryRegister(NewEnumBase(4, "RUNNING", "Running").WithExternalCode("A07"))
+		assert.EqualError(t, err, "duplicate external code A07: used by ACTIVE")
+	})
+
+	t.Run("unregister", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().Register(NewEnumBase(1, "PENDING", "").WithExternalCode(100))
+		set.Unregister("PENDING")
+		_, exists := set.GetByExternalCode(100)
+		assert.False(t, exists)
+		assert.NoError(t, set.TryRegister(NewEnumBase(2, "QUEUED", "").WithExternalCode(100)))
+	})
+
+	t.Run("nil enums", func(t *testing.T) {
+		var enum *EnumBase
+		assert.Nil(t, enum.WithExternalCode("X"))
+		assert.Nil(t, enum.ExternalCode())
+	})
+}
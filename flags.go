@@ -0,0 +1,337 @@
+package goenum
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// maxFlags is the number of distinct flags a uint64 composite can hold
+const maxFlags = 64
+
+// CompositeEnumSet is an EnumSet of composite flags
+type CompositeEnumSet struct {
+	*EnumSet[*CompositeEnumBase]
+}
+
+// NewCompositeEnumSet creates a new CompositeEnumSet instance. Its flags must each be a
+// single bit, unless marked with AsCombination.
+func NewCompositeEnumSet() *CompositeEnumSet {
+	set := &CompositeEnumSet{EnumSet: NewEnumSet[*CompositeEnumBase]()}
+	set.AddValidator(set.checkFlag)
+	return set
+}
+
+// AsCombination marks the composite as a named combination of other flags, such as
+// READ_WRITE, so a CompositeEnumSet accepts it alongside the flags it overlaps
+func (e *CompositeEnumBase) AsCombination() *CompositeEnumBase {
+	if e == nil {
+		return nil
+	}
+	e.combination = true
+	return e
+}
+
+// IsCombination reports whether the composite was marked with AsCombination
+func (e *CompositeEnumBase) IsCombination() bool {
+	return e != nil && e.combination
+}
+
+// checkFlag rejects flags that are not a single bit, which would overlap other flags
+// and make HasFlag ambiguous, and combinations of bits no registered flag covers.
+// Single-bit flags overlapping each other are already rejected as duplicate values.
+func (s *CompositeEnumSet) checkFlag(flag *CompositeEnumBase) error {
+	if flag == nil {
+		return errors.New("flag is nil")
+	}
+	if flag.combination {
+		var covered uint64
+		for _, single := range s.singleFlags() {
+			covered |= single.flags
+		}
+		if uncovered := flag.flags &^ covered; uncovered != 0 {
+			return fmt.Errorf("combination has bits %#x not covered by any registered flag", uncovered)
+		}
+		return nil
+	}
+	if bits.OnesCount64(flag.flags) != 1 {
+		return fmt.Errorf("flag value %#x is not a single bit, mark combinations with AsCombination", flag.flags)
+	}
+	return nil
+}
+
+// Register adds a flag to the set and returns the CompositeEnumSet for chaining.
+// It panics if the flag is a duplicate or fails validation, unless in panic-free mode.
+func (s *CompositeEnumSet) Register(flag *CompositeEnumBase) *CompositeEnumSet {
+	if err := s.TryRegister(flag); err != nil {
+		s.fail(err)
+	}
+	return s
+}
+
+// TryRegister adds a flag to the set, returning an error instead of panicking.
+// The flag, and composites derived from it, are bound to the first CompositeEnumSet
+// they are registered in, which Flags decomposes them against.
+func (s *CompositeEnumSet) TryRegister(flag *CompositeEnumBase) error {
+	if err := s.EnumSet.TryRegister(flag); err != nil {
+		return err
+	}
+	if flag != nil && flag.registry == nil {
+		flag.registry = s
+	}
+	return nil
+}
+
+// singleFlags returns the registered flags with exactly one bit set, in bit order
+func (s *CompositeEnumSet) singleFlags() []*CompositeEnumBase {
+	var result []*CompositeEnumBase
+	for _, flag := range s.values {
+		if bits.OnesCount64(flag.flags) == 1 {
+			result = append(result, flag)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].flags < result[j].flags
+	})
+	return result
+}
+
+// Flags returns the registered single-bit flags set in the composite, in bit order.
+// It returns nil for composites not bound to a CompositeEnumSet.
+func (e *CompositeEnumBase) Flags() []CompositeEnum {
+	var result []CompositeEnum
+	for flag := range e.FlagSeq() {
+		result = append(result, flag)
+	}
+	return result
+}
+
+// FlagSeq iterates over the flags returned by Flags, e.g.
+//
+//	for permission := range granted.FlagSeq() { ... }
+func (e *CompositeEnumBase) FlagSeq() iter.Seq[CompositeEnum] {
+	return func(yield func(CompositeEnum) bool) {
+		if e == nil || e.registry == nil {
+			return
+		}
+		for _, flag := range e.registry.singleFlags() {
+			if e.flags&flag.flags == flag.flags && !yield(flag) {
+				return
+			}
+		}
+	}
+}
+
+// ToNames returns the names of the parts of the composite, in the canonical order FromUint64
+// names it, for storing it as a list. It returns nil for composites not bound to a
+// CompositeEnumSet.
+func (e *CompositeEnumBase) ToNames() []string {
+	if e == nil || e.registry == nil {
+		return nil
+	}
+	parts, _ := e.registry.decompose(e.flags)
+	var names []string
+	for _, part := range parts {
+		names = append(names, part.name)
+	}
+	return names
+}
+
+// FromUint64 reconstructs a composite from its stored bits. A value matching a registered
+// flag returns that flag; any other value gets its canonical name, the names of its parts
+// joined by "|", and is bound to registry. Named combinations are preferred over the flags
+// they cover, largest first, so 7 renders as READ_WRITE|EXECUTE once READ_WRITE is
+// registered. Bits no registered flag covers are an error.
+func FromUint64(v uint64, registry *CompositeEnumSet) (*CompositeEnumBase, error) {
+	if registry == nil {
+		return nil, errors.New("composite registry is nil")
+	}
+	if flag, exists := registry.GetByValue(v); exists {
+		return flag, nil
+	}
+
+	parts, remaining := registry.decompose(v)
+	if remaining != 0 {
+		return nil, fmt.Errorf("value %#x has bits %#x not covered by any registered flag", v, remaining)
+	}
+	names := make([]string, len(parts))
+	for i, part := range parts {
+		names[i] = part.name
+	}
+	return &CompositeEnumBase{
+		EnumBase: NewEnumBase(v, strings.Join(names, "|"), ""),
+		flags:    v,
+		registry: registry,
+	}, nil
+}
+
+// decompose splits v into disjoint registered combinations, largest first, and single
+// flags, ordered by their lowest bit, and returns the bits left over
+func (s *CompositeEnumSet) decompose(v uint64) ([]*CompositeEnumBase, uint64) {
+	var combinations []*CompositeEnumBase
+	for _, flag := range s.values {
+		if flag.combination && flag.flags != 0 {
+			combinations = append(combinations, flag)
+		}
+	}
+	sort.Slice(combinations, func(i, j int) bool {
+		ci, cj := bits.OnesCount64(combinations[i].flags), bits.OnesCount64(combinations[j].flags)
+		if ci != cj {
+			return ci > cj
+		}
+		return combinations[i].flags < combinations[j].flags
+	})
+
+	var parts []*CompositeEnumBase
+	remaining := v
+	for _, flag := range append(combinations, s.singleFlags()...) {
+		if flag.flags&^remaining == 0 {
+			parts = append(parts, flag)
+			remaining &^= flag.flags
+		}
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		return bits.TrailingZeros64(parts[i].flags) < bits.TrailingZeros64(parts[j].flags)
+	})
+	return parts, remaining
+}
+
+// FromNames combines the flags named, by name or alias, into a composite named like
+// FromUint64 does, returning an *UnknownEnumError for names not in registry
+func FromNames(names []string, registry *CompositeEnumSet) (*CompositeEnumBase, error) {
+	if registry == nil {
+		return nil, errors.New("composite registry is nil")
+	}
+	var v uint64
+	for _, name := range names {
+		flag, err := registry.GetByNameE(name)
+		if err != nil {
+			return nil, err
+		}
+		v |= flag.flags
+	}
+	return FromUint64(v, registry)
+}
+
+// Count returns the number of bits set in the composite
+func (e *CompositeEnumBase) Count() int {
+	if e == nil {
+		return 0
+	}
+	return bits.OnesCount64(e.flags)
+}
+
+// Equal reports whether both composites have exactly the same flags set, regardless of
+// the names they were built with: READ|WRITE equals WRITE|READ.
+func (e *CompositeEnumBase) Equal(other CompositeEnum) bool {
+	otherBase, ok := other.(*CompositeEnumBase)
+	if !ok || otherBase == nil {
+		return e == nil
+	}
+	return e != nil && e.flags == otherBase.flags
+}
+
+// ContainsAll reports whether every given flag is set; it is an alias of HasAllFlags
+func (e *CompositeEnumBase) ContainsAll(flags ...CompositeEnum) bool {
+	return e.HasAllFlags(flags...)
+}
+
+// ContainsAny reports whether at least one of the given flags is set
+func (e *CompositeEnumBase) ContainsAny(flags ...CompositeEnum) bool {
+	for _, flag := range flags {
+		if e.HasFlag(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// sharedRegistry returns the registry of e, or that of other if e is not bound to one
+func (e *CompositeEnumBase) sharedRegistry(other *CompositeEnumBase) *CompositeEnumSet {
+	if e.registry == nil && other != nil {
+		return other.registry
+	}
+	return e.registry
+}
+
+// FlagSetBuilder declares flags in order and assigns them sequential bits, starting at bit 0
+type FlagSetBuilder struct {
+	flags []flagDeclaration
+}
+
+// flagDeclaration is a flag added to a FlagSetBuilder
+type flagDeclaration struct {
+	name        string
+	description string
+	aliases     []string
+	combines    []string
+	combination bool
+}
+
+// NewFlagSetBuilder creates a new, empty FlagSetBuilder
+func NewFlagSetBuilder() *FlagSetBuilder {
+	return &FlagSetBuilder{}
+}
+
+// Add declares the next flag and returns the FlagSetBuilder for chaining
+func (b *FlagSetBuilder) Add(name string, description string, aliases ...string) *FlagSetBuilder {
+	b.flags = append(b.flags, flagDeclaration{name: name, description: description, aliases: aliases})
+	return b
+}
+
+// Combine declares a named combination of flags added before it, such as READ_WRITE,
+// and returns the FlagSetBuilder for chaining. Combinations take no bit of their own.
+func (b *FlagSetBuilder) Combine(name string, description string, flags ...string) *FlagSetBuilder {
+	b.flags = append(b.flags, flagDeclaration{name: name, description: description, combines: flags, combination: true})
+	return b
+}
+
+// Build creates the declared flags, the first with bit 0 (value 1), the second with bit 1
+// and so on, and registers them in a new CompositeEnumSet followed by the combinations.
+// It returns the flags and combinations in the order they were declared, or an error if
+// there are more than 64 flags or a flag cannot be registered, e.g. because of a
+// duplicate name.
+func (b *FlagSetBuilder) Build() ([]*CompositeEnumBase, *CompositeEnumSet, error) {
+	var count int
+	for _, declaration := range b.flags {
+		if !declaration.combination {
+			count++
+		}
+	}
+	if count > maxFlags {
+		return nil, nil, fmt.Errorf("too many flags: %d declared, at most %d fit in a uint64", count, maxFlags)
+	}
+
+	set := NewCompositeEnumSet()
+	flags := make([]*CompositeEnumBase, len(b.flags))
+	bit := 0
+	for i, declaration := range b.flags {
+		if declaration.combination {
+			continue
+		}
+		flag := NewCompositeEnumBase(uint64(1)<<bit, declaration.name, declaration.description, declaration.aliases...)
+		if err := set.TryRegister(flag); err != nil {
+			return nil, nil, fmt.Errorf("flag %s: %w", declaration.name, err)
+		}
+		flags[i] = flag
+		bit++
+	}
+	for i, declaration := range b.flags {
+		if !declaration.combination {
+			continue
+		}
+		combined, err := FromNames(declaration.combines, set)
+		if err != nil {
+			return nil, nil, fmt.Errorf("flag %s: %w", declaration.name, err)
+		}
+		flag := NewCompositeEnumBase(combined.flags, declaration.name, declaration.description).AsCombination()
+		if err := set.TryRegister(flag); err != nil {
+			return nil, nil, fmt.Errorf("flag %s: %w", declaration.name, err)
+		}
+		flags[i] = flag
+	}
+	return flags, set, nil
+}
@@ -0,0 +1,234 @@
+package goenum
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagSetBuilder(t *testing.T) {
+	t.Run("sequential bits", func(t *testing.T) {
+		flags, set, err := NewFlagSetBuilder().
+			Add("READ", "Read access", "R").
+			Add("WRITE", "Write access").
+			Add("EXECUTE", "Execute access").
+			Build()
+		assert.NoError(t, err)
+		assert.Len(t, flags, 3)
+		for i, flag := range flags {
+			assert.Equal(t, uint64(1)<<i, flag.Value())
+		}
+
+		read, exists := set.GetByName("r")
+		assert.True(t, exists)
+		assert.Equal(t, flags[0], read)
+		assert.Equal(t, "Read access", read.Description())
+		assert.True(t, flags[0].Or(flags[2]).HasFlag(flags[2]))
+	})
+
+	t.Run("64 flags", func(t *testing.T) {
+		builder := NewFlagSetBuilder()
+		for i := 0; i < 64; i++ {
+			builder.Add(fmt.Sprintf("FLAG_%d", i), "")
+		}
+		flags, _, err := builder.Build()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1)<<63, flags[63].Value())
+
+		builder.Add("FLAG_64", "")
+		_, _, err = builder.Build()
+		assert.EqualError(t, err, "too many flags: 65 declared, at most 64 fit in a uint64")
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		_, _, err := NewFlagSetBuilder().Add("READ", "").Add("READ", "").Build()
+		assert.EqualError(t, err, "flag READ: duplicate enum name: READ")
+	})
+}
+
+func TestCompositeFlags(t *testing.T) {
+	flags, _, err := NewFlagSetBuilder().Add("READ", "").Add("WRITE", "").Add("EXECUTE", "").Build()
+	assert.NoError(t, err)
+	read, write, execute := flags[0], flags[1], flags[2]
+
+	t.Run("Flags()", func(t *testing.T) {
+		granted := execute.Or(read)
+		assert.Equal(t, []CompositeEnum{read, execute}, granted.(*CompositeEnumBase).Flags())
+		assert.Equal(t, []CompositeEnum{write}, granted.Not().(*CompositeEnumBase).Flags(), "unregistered bits should be ignored")
+		assert.Empty(t, granted.RemoveFlag(read).RemoveFlag(execute).(*CompositeEnumBase).Flags())
+	})
+
+	t.Run("FlagSeq()", func(t *testing.T) {
+		var names []string
+		for flag := range read.Or(write).Or(execute).(*CompositeEnumBase).FlagSeq() {
+			names = append(names, flag.String())
+			if flag == write {
+				break
+			}
+		}
+		assert.Equal(t, []string{"READ", "WRITE"}, names)
+	})
+
+	t.Run("unbound composites", func(t *testing.T) {
+		unbound := NewCompositeEnumBase(0, "A", "").Or(NewCompositeEnumBase(1, "B", ""))
+		assert.Nil(t, unbound.(*CompositeEnumBase).Flags())
+		assert.Equal(t, []CompositeEnum{read}, NewCompositeEnumBase(10, "C", "").Or(read).(*CompositeEnumBase).Flags(),
+			"composites should inherit the registry of either operand")
+
+		var nilFlag *CompositeEnumBase
+		assert.Nil(t, nilFlag.Flags())
+	})
+}
+
+func TestCompositeChecks(t *testing.T) {
+	read := NewCompositeEnumBase(0, "READ", "")
+	write := NewCompositeEnumBase(1, "WRITE", "")
+	execute := NewCompositeEnumBase(2, "EXECUTE", "")
+	readWrite := read.Or(write).(*CompositeEnumBase)
+
+	t.Run("Count()", func(t *testing.T) {
+		assert.Equal(t, 1, read.Count())
+		assert.Equal(t, 2, readWrite.Count())
+		assert.Equal(t, 0, readWrite.RemoveFlag(readWrite).(*CompositeEnumBase).Count())
+		var nilFlag *CompositeEnumBase
+		assert.Equal(t, 0, nilFlag.Count())
+	})
+
+	t.Run("Equal()", func(t *testing.T) {
+		assert.True(t, readWrite.Equal(write.Or(read)))
+		assert.False(t, readWrite.Equal(read))
+		assert.False(t, readWrite.Equal(nil))
+
+		var nilFlag *CompositeEnumBase
+		assert.True(t, nilFlag.Equal(nil))
+		assert.False(t, nilFlag.Equal(read))
+	})
+
+	t.Run("ContainsAll() and ContainsAny()", func(t *testing.T) {
+		assert.True(t, readWrite.ContainsAll(read, write))
+		assert.False(t, readWrite.ContainsAll(read, execute))
+		assert.True(t, readWrite.ContainsAny(execute, write))
+		assert.False(t, readWrite.ContainsAny(execute))
+		assert.False(t, readWrite.ContainsAny())
+	})
+}
+
+func TestCompositeRoundTrip(t *testing.T) {
+	flags, permissions, err := NewFlagSetBuilder().Add("READ", "", "R").Add("WRITE", "").Add("EXECUTE", "").Build()
+	assert.NoError(t, err)
+	read, write, execute := flags[0], flags[1], flags[2]
+
+	t.Run("FromUint64()", func(t *testing.T) {
+		granted, err := FromUint64(5, permissions)
+		assert.NoError(t, err)
+		assert.Equal(t, "READ|EXECUTE", granted.String())
+		assert.True(t, granted.Equal(execute.Or(read)))
+		assert.Equal(t, []CompositeEnum{read, execute}, granted.Flags())
+
+		single, err := FromUint64(2, permissions)
+		assert.NoError(t, err)
+		assert.Same(t, write, single)
+
+		none, err := FromUint64(0, permissions)
+		assert.NoError(t, err)
+		assert.True(t, none.IsEmpty())
+
+		_, err = FromUint64(9, permissions)
+		assert.EqualError(t, err, "value 0x9 has bits 0x8 not covered by any registered flag")
+		_, err = FromUint64(1, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("ToNames() and FromNames()", func(t *testing.T) {
+		assert.Equal(t, []string{"READ", "WRITE"}, write.Or(read).(*CompositeEnumBase).ToNames())
+		assert.Nil(t, NewCompositeEnumBase(0, "A", "").ToNames())
+
+		granted, err := FromNames([]string{"EXECUTE", "r"}, permissions)
+		assert.NoError(t, err)
+		assert.Equal(t, "READ|EXECUTE", granted.String())
+		assert.Equal(t, []string{"READ", "EXECUTE"}, granted.ToNames())
+
+		_, err = FromNames([]string{"READ", "DELETE"}, permissions)
+		assert.ErrorIs(t, err, ErrUnknownEnum)
+	})
+}
+
+func TestCompositeEnumSetChecks(t *testing.T) {
+	_, permissions, err := NewFlagSetBuilder().Add("READ", "").Add("WRITE", "").Build()
+	assert.NoError(t, err)
+
+	t.Run("rejects values that are not a single bit", func(t *testing.T) {
+		err := permissions.TryRegister(NewCompositeEnumBase(uint64(3), "READ_WRITE", ""))
+		assert.ErrorContains(t, err, "flag value 0x3 is not a single bit")
+		err = permissions.TryRegister(NewCompositeEnumBase(uint64(0), "NONE", ""))
+		assert.ErrorContains(t, err, "flag value 0x0 is not a single bit")
+		assert.Panics(t, func() {
+			permissions.Register(NewCompositeEnumBase(uint64(6), "WRITE_EXECUTE", ""))
+		})
+	})
+
+	t.Run("rejects overlapping single flags", func(t *testing.T) {
+		err := permissions.TryRegister(NewCompositeEnumBase(1, "MODIFY", ""))
+		assert.EqualError(t, err, "duplicate enum value: 2")
+	})
+
+	t.Run("accepts marked combinations of registered flags", func(t *testing.T) {
+		readWrite := NewCompositeEnumBase(uint64(3), "READ_WRITE", "").AsCombination()
+		assert.True(t, readWrite.IsCombination())
+		assert.NoError(t, permissions.TryRegister(readWrite))
+		assert.NoError(t, permissions.TryRegister(NewCompositeEnumBase(uint64(0), "NONE", "").AsCombination()))
+
+		err := permissions.TryRegister(NewCompositeEnumBase(uint64(5), "READ_EXECUTE", "").AsCombination())
+		assert.ErrorContains(t, err, "combination has bits 0x4 not covered by any registered flag")
+	})
+}
+
+func TestNamedCombinations(t *testing.T) {
+	flags, permissions, err := NewFlagSetBuilder().
+		Add("READ", "").
+		Add("WRITE", "").
+		Combine("READ_WRITE", "Read and write access", "READ", "WRITE").
+		Add("EXECUTE", "").
+		Combine("ALL", "", "READ_WRITE", "EXECUTE").
+		Combine("NONE", "").
+		Build()
+	assert.NoError(t, err)
+	read, write, readWrite, execute, all, none := flags[0], flags[1], flags[2], flags[3], flags[4], flags[5]
+
+	t.Run("Combine()", func(t *testing.T) {
+		assert.Equal(t, uint64(4), execute.Value(), "combinations should not take a bit")
+		assert.Equal(t, uint64(3), readWrite.Value())
+		assert.Equal(t, uint64(7), all.Value())
+		assert.Equal(t, uint64(0), none.Value())
+		assert.True(t, readWrite.IsCombination())
+		assert.Equal(t, "Read and write access", readWrite.Description())
+
+		_, _, err := NewFlagSetBuilder().Add("READ", "").Combine("READ_DELETE", "", "READ", "DELETE").Build()
+		assert.ErrorContains(t, err, `flag READ_DELETE: unknown enum "DELETE"`)
+	})
+
+	t.Run("canonical names prefer combinations", func(t *testing.T) {
+		granted, err := FromUint64(3, permissions)
+		assert.NoError(t, err)
+		assert.Same(t, readWrite, granted)
+
+		granted, err = FromNames([]string{"READ", "WRITE"}, permissions)
+		assert.NoError(t, err)
+		assert.Same(t, readWrite, granted)
+
+		_, permissions, err := NewFlagSetBuilder().Add("READ", "").Add("WRITE", "").Add("EXECUTE", "").
+			Combine("READ_WRITE", "", "READ", "WRITE").Build()
+		assert.NoError(t, err)
+		granted, err = FromUint64(7, permissions)
+		assert.NoError(t, err)
+		assert.Equal(t, "READ_WRITE|EXECUTE", granted.String())
+		assert.Equal(t, []string{"READ_WRITE", "EXECUTE"}, granted.ToNames())
+	})
+
+	t.Run("Flags() lists single flags", func(t *testing.T) {
+		assert.Equal(t, []CompositeEnum{read, write}, readWrite.Flags())
+		assert.Equal(t, []string{"ALL"}, read.Or(write).Or(execute).(*CompositeEnumBase).ToNames())
+		assert.Nil(t, none.ToNames())
+	})
+}
@@ -0,0 +1,153 @@
+package goenum
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefinitionCodec decodes enum definitions from a file format other than JSON,
+// e.g. HCL, XML or an internal DSL. Loaders return a *DefinitionError from Decode as
+// is, so codecs can locate the definition they reject.
+type DefinitionCodec interface {
+	Decode(reader io.Reader) ([]EnumDefinition, error)
+}
+
+// DefinitionCodecFunc adapts a function to DefinitionCodec
+type DefinitionCodecFunc func(reader io.Reader) ([]EnumDefinition, error)
+
+// Decode calls f(reader)
+func (f DefinitionCodecFunc) Decode(reader io.Reader) ([]EnumDefinition, error) {
+	return f(reader)
+}
+
+// loaderFormats holds the registered definition codecs keyed by file extension
+var loaderFormats = struct {
+	sync.RWMutex
+	codecs map[string]DefinitionCodec
+}{codecs: make(map[string]DefinitionCodec)}
+
+// normalizeExtension returns ext in lower case with a leading dot
+func normalizeExtension(ext string) string {
+	ext = strings.ToLower(ext)
+	if ext != "" && !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return ext
+}
+
+// RegisterLoaderFormat makes LoadFromFile and LoadFromDirectory decode files with the
+// extension ext (e.g. ".hcl") using codec. It panics if ext is empty, ".json", or
+// already registered, unless in panic-free mode.
+func RegisterLoaderFormat(ext string, codec DefinitionCodec) {
+	if err := TryRegisterLoaderFormat(ext, codec); err != nil {
+		fail(err)
+	}
+}
+
+// TryRegisterLoaderFormat registers codec for ext like RegisterLoaderFormat, returning
+// an error instead of panicking
+func TryRegisterLoaderFormat(ext string, codec DefinitionCodec) error {
+	ext = normalizeExtension(ext)
+	if ext == "" {
+		return fmt.Errorf("loader format extension cannot be empty")
+	}
+	if codec == nil {
+		return fmt.Errorf("cannot register nil codec for loader format: %s", ext)
+	}
+	if ext == ".json" {
+		return fmt.Errorf("the .json loader format is built in")
+	}
+
+	loaderFormats.Lock()
+	defer loaderFormats.Unlock()
+	if _, exists := loaderFormats.codecs[ext]; exists {
+		return fmt.Errorf("duplicate loader format: %s", ext)
+	}
+	loaderFormats.codecs[ext] = codec
+	return nil
+}
+
+// UnregisterLoaderFormat removes the codec registered for ext
+func UnregisterLoaderFormat(ext string) {
+	loaderFormats.Lock()
+	defer loaderFormats.Unlock()
+	delete(loaderFormats.codecs, normalizeExtension(ext))
+}
+
+// lookupLoaderFormat returns the codec registered for ext
+func lookupLoaderFormat(ext string) (DefinitionCodec, bool) {
+	loaderFormats.RLock()
+	defer loaderFormats.RUnlock()
+	codec, exists := loaderFormats.codecs[normalizeExtension(ext)]
+	return codec, exists
+}
+
+// loaderFormatPatterns returns glob patterns matching JSON and every registered format
+func loaderFormatPatterns() []string {
+	loaderFormats.RLock()
+	defer loaderFormats.RUnlock()
+	patterns := []string{"*.json"}
+	for ext := range loaderFormats.codecs {
+		patterns = append(patterns, "*"+ext)
+	}
+	sort.Strings(patterns[1:])
+	return patterns
+}
+
+// LoadFromFile loads enum definitions from a file, choosing the decoder by extension:
+// JSON for ".json", otherwise the codec registered with RegisterLoaderFormat
+func (l *DynamicEnumLoader) LoadFromFile(filename string) error {
+	ext := filepath.Ext(filename)
+	if strings.EqualFold(ext, ".json") {
+		return l.LoadFromJSON(filename)
+	}
+
+	codec, exists := lookupLoaderFormat(ext)
+	if !exists {
+		return fmt.Errorf("unsupported loader format: %q", ext)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	return withFile(l.loadFromCodec(file, codec, normalizeExtension(ext)), filename)
+}
+
+// LoadFromCodec loads enum definitions decoded from reader by codec. Document validators
+// are passed an empty format.
+// Rejected definitions are reported as *DefinitionError.
+func (l *DynamicEnumLoader) LoadFromCodec(reader io.Reader, codec DefinitionCodec) error {
+	return l.loadFromCodec(reader, codec, "")
+}
+
+// loadFromCodec loads enum definitions of the given format decoded from reader by codec
+func (l *DynamicEnumLoader) loadFromCodec(reader io.Reader, codec DefinitionCodec, format string) error {
+	reader, err := l.validatedDocument(format, reader)
+	if err != nil {
+		return err
+	}
+	definitions, err := codec.Decode(reader)
+	if definitionErr, ok := err.(*DefinitionError); ok {
+		return definitionErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode definitions: %w", err)
+	}
+
+	for index, def := range definitions {
+		if err := l.loadDefinition(def); err != nil {
+			return definitionErrorAt(index, def.Name, err)
+		}
+	}
+
+	l.applyMigrations()
+	return nil
+}
@@ -0,0 +1,77 @@
+package goenum
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// propertiesCodec decodes "NAME=VALUE" lines
+var propertiesCodec = DefinitionCodecFunc(func(reader io.Reader) ([]EnumDefinition, error) {
+	var definitions []EnumDefinition
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		name, value, found := strings.Cut(scanner.Text(), "=")
+		if !found {
+			return nil, errors.New("expected NAME=VALUE")
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, EnumDefinition{Name: name, Value: number})
+	}
+	return definitions, scanner.Err()
+})
+
+func TestRegisterLoaderFormat(t *testing.T) {
+	RegisterLoaderFormat("props", propertiesCodec)
+	defer UnregisterLoaderFormat(".props")
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+
+	t.Run("panics on invalid registrations", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterLoaderFormat("", propertiesCodec) })
+		assert.Panics(t, func() { RegisterLoaderFormat(".xml", nil) })
+		assert.Panics(t, func() { RegisterLoaderFormat(".JSON", propertiesCodec) })
+		assert.Panics(t, func() { RegisterLoaderFormat(".PROPS", propertiesCodec) })
+	})
+
+	t.Run("LoadFromDirectory dispatches by extension", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(`[{"name": "A", "value": 1}]`), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "b.props"), []byte("B=2\nC=3"), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0644))
+
+		loader := NewDynamicEnumLoader(options)
+		assert.NoError(t, loader.LoadFromDirectory(dir))
+		assert.ElementsMatch(t, []string{"A", "B", "C"}, loader.GetEnumSet().Names())
+		enum, exists := loader.GetEnumSet().GetByName("C")
+		assert.True(t, exists)
+		assert.Equal(t, 3, enum.Value())
+	})
+
+	t.Run("LoadFromFile", func(t *testing.T) {
+		dir := t.TempDir()
+		broken := filepath.Join(dir, "broken.props")
+		assert.NoError(t, os.WriteFile(broken, []byte("BROKEN"), 0644))
+		unknown := filepath.Join(dir, "enums.xml")
+		assert.NoError(t, os.WriteFile(unknown, []byte("<enums/>"), 0644))
+
+		loader := NewDynamicEnumLoader(options)
+		assert.ErrorContains(t, loader.LoadFromFile(broken), "expected NAME=VALUE")
+		assert.ErrorContains(t, loader.LoadFromFile(unknown), "unsupported loader format")
+	})
+
+	t.Run("LoadFromCodec validates definitions", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromCodec(strings.NewReader("=1"), propertiesCodec)
+		assert.ErrorContains(t, err, "enum name cannot be empty")
+	})
+}
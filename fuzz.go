@@ -0,0 +1,65 @@
+package goenum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// marshalEnumFormat encodes an enum in the given JSON format without touching its own configuration
+func marshalEnumFormat(enum Enum, format JSONFormat) ([]byte, error) {
+	config := &EnumJSONConfig{Format: format}
+	if m, ok := enum.(interface {
+		MarshalJSONWithConfig(*EnumJSONConfig) ([]byte, error)
+	}); ok {
+		return m.MarshalJSONWithConfig(config)
+	}
+	base := &EnumBase{
+		value:       enum.Value(),
+		name:        enum.String(),
+		description: enum.Description(),
+		aliases:     enum.Aliases(),
+		group:       groupOf(enum),
+		displayName: displayNameOf(enum),
+		tags:        tagsOf(enum),
+	}
+	return base.MarshalJSONWithConfig(config)
+}
+
+// FuzzCorpus returns realistic seed inputs for fuzzing enum parsing: every name and alias,
+// and every enum encoded in each JSON format
+func (es *EnumSet[T]) FuzzCorpus() [][]byte {
+	corpus := make([][]byte, 0)
+	for _, enum := range es.Values() {
+		corpus = append(corpus, []byte(enum.String()))
+		for _, alias := range enum.Aliases() {
+			corpus = append(corpus, []byte(alias))
+		}
+		for _, format := range []JSONFormat{JSONFormatName, JSONFormatValue, JSONFormatFull} {
+			if data, err := marshalEnumFormat(enum, format); err == nil {
+				corpus = append(corpus, data)
+			}
+		}
+	}
+	return corpus
+}
+
+// WriteFuzzCorpus writes the set's fuzz corpus to dir in the Go fuzzing corpus file format,
+// e.g. to testdata/fuzz/FuzzParseStatus
+func (es *EnumSet[T]) WriteFuzzCorpus(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create corpus directory: %w", err)
+	}
+	for _, data := range es.FuzzCorpus() {
+		sum := sha256.Sum256(data)
+		content := "go test fuzz v1\n[]byte(" + strconv.Quote(string(data)) + ")\n"
+		filename := filepath.Join(dir, hex.EncodeToString(sum[:8]))
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write corpus file: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package goenum
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetFuzzCorpus(t *testing.T) {
+	t.Run("FuzzCorpus() contents", func(t *testing.T) {
+		corpus := make([]string, 0)
+		for _, data := range TestEnumSet.FuzzCorpus() {
+			corpus = append(corpus, string(data))
+		}
+		assert.Contains(t, corpus, "A", "FuzzCorpus() should contain names")
+		assert.Contains(t, corpus, "CHARLIE", "FuzzCorpus() should contain aliases")
+		assert.Contains(t, corpus, `"B"`, "FuzzCorpus() should contain name format JSON")
+		assert.Contains(t, corpus, `2`, "FuzzCorpus() should contain value format JSON")
+		assert.Contains(t, corpus, `{"name":"C","value":3,"description":"Third enum","aliases":["CHARLIE","THIRD"]}`, "FuzzCorpus() should contain full format JSON")
+	})
+
+	t.Run("FuzzCorpus() full format keeps display names and tags", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().
+			Register(NewEnumBase(1, "IN_PROGRESS", "Being worked on").WithDisplayName("In Progress").WithTags("open"))
+		corpus := make([]string, 0)
+		for _, data := range set.FuzzCorpus() {
+			corpus = append(corpus, string(data))
+		}
+		assert.Contains(t, corpus, `{"name":"IN_PROGRESS","value":1,"description":"Being worked on","displayName":"In Progress","tags":["open"]}`, "FuzzCorpus() should encode full format like MarshalJSON")
+	})
+
+	t.Run("FuzzCorpus() is deterministic", func(t *testing.T) {
+		assert.Equal(t, TestEnumSet.FuzzCorpus(), TestEnumSet.FuzzCorpus(), "FuzzCorpus() should return the same order every time")
+	})
+
+	t.Run("WriteFuzzCorpus()", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "testdata", "fuzz", "FuzzParse")
+		assert.NoError(t, TestEnumSet.WriteFuzzCorpus(dir))
+
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, entries, len(TestEnumSet.FuzzCorpus()), "WriteFuzzCorpus() should write one file per seed")
+
+		data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(data), "go test fuzz v1\n[]byte("), "WriteFuzzCorpus() should use the Go corpus file format")
+	})
+}
@@ -0,0 +1,41 @@
+package goenum
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// EnumGenerator produces random registered enums for property-based tests.
+// It implements testing/quick's Generator interface.
+type EnumGenerator[T Enum] struct {
+	set *EnumSet[T]
+}
+
+// Generator returns an EnumGenerator drawing values from the set
+func (es *EnumSet[T]) Generator() *EnumGenerator[T] {
+	return &EnumGenerator[T]{set: es}
+}
+
+// Draw returns a random registered enum, or the zero value if the set is empty
+func (g *EnumGenerator[T]) Draw(r *rand.Rand) T {
+	enum, _ := g.set.Random(r)
+	return enum
+}
+
+// Generate implements quick.Generator. An empty set generates the zero value of T, typed
+// even when T is an interface, since testing/quick panics on invalid values.
+func (g *EnumGenerator[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	enum, exists := g.set.Random(r)
+	if !exists {
+		return reflect.Zero(reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return reflect.ValueOf(&enum).Elem()
+}
+
+// Values fills args with random registered enums; it can be used as quick.Config.Values
+// for properties whose arguments are all of the set's enum type
+func (g *EnumGenerator[T]) Values(args []reflect.Value, r *rand.Rand) {
+	for i := range args {
+		args[i] = g.Generate(r, 0)
+	}
+}
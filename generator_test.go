@@ -0,0 +1,50 @@
+package goenum
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumGenerator(t *testing.T) {
+	var _ quick.Generator = TestEnumSet.Generator()
+
+	t.Run("Draw() returns registered values", func(t *testing.T) {
+		gen := TestEnumSet.Generator()
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 50; i++ {
+			assert.True(t, TestEnumSet.Contains(gen.Draw(r)), "Draw() should return registered enums")
+		}
+	})
+
+	t.Run("Draw() on empty set", func(t *testing.T) {
+		enum := NewEnumSet[TestEnum]().Generator().Draw(nil)
+		assert.False(t, enum.IsValid(), "Draw() should return zero value for empty set")
+	})
+
+	t.Run("empty sets of interface type", func(t *testing.T) {
+		gen := NewEnumSet[Enum]().Generator()
+		value := gen.Generate(rand.New(rand.NewSource(1)), 0)
+		assert.True(t, value.IsValid(), "Generate() should return a typed zero value for an empty set")
+		assert.True(t, value.IsNil())
+
+		err := quick.Check(func(e Enum) bool { return e == nil }, &quick.Config{
+			Rand:   rand.New(rand.NewSource(2)),
+			Values: gen.Values,
+		})
+		assert.NoError(t, err, "quick.Check() should not panic on an empty set")
+	})
+
+	t.Run("quick.Check with Values", func(t *testing.T) {
+		property := func(a, b TestEnum) bool {
+			return TestEnumSet.Contains(a) && TestEnumSet.Contains(b)
+		}
+		err := quick.Check(property, &quick.Config{
+			Rand:   rand.New(rand.NewSource(3)),
+			Values: TestEnumSet.Generator().Values,
+		})
+		assert.NoError(t, err, "quick.Check() should only see registered enums")
+	})
+}
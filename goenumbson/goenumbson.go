@@ -0,0 +1,112 @@
+// Package goenumbson stores goenum enums in MongoDB documents by name, resolving them
+// against their sets when the driver decodes, so structs with enum fields round-trip
+// without custom UnmarshalBSON methods.
+//
+// It does not import the MongoDB driver. The codecs are instantiated with the driver's
+// context and reader/writer types and registered on a bsoncodec.RegistryBuilder:
+//
+//	rb := bson.NewRegistryBuilder()
+//	err := goenumbson.RegisterCodecs[bsoncodec.EncodeContext, bsonrw.ValueWriter,
+//		bsoncodec.DecodeContext, bsonrw.ValueReader](rb, StatusEnumSet, TierEnumSet)
+//	client, err := mongo.Connect(ctx, options.Client().SetRegistry(rb.Build()))
+package goenumbson
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// ValueWriter is the part of bsonrw.ValueWriter the codecs use
+type ValueWriter interface {
+	WriteString(s string) error
+	WriteNull() error
+}
+
+// ValueReader is the part of bsonrw.ValueReader the codecs use
+type ValueReader interface {
+	ReadString() (string, error)
+	ReadNull() error
+}
+
+// Codec encodes the enums of one set as BSON strings and decodes them back, implementing
+// bsoncodec.ValueEncoder and bsoncodec.ValueDecoder for the driver types EC, VW, DC and VR
+type Codec[EC any, VW ValueWriter, DC any, VR ValueReader] struct {
+	set      goenum.AnyEnumSet
+	enumType reflect.Type
+}
+
+// NewCodec creates a codec for the enums of set, which must not be empty, since its
+// enums tell the type the codec handles
+func NewCodec[EC any, VW ValueWriter, DC any, VR ValueReader](set goenum.AnyEnumSet) (*Codec[EC, VW, DC, VR], error) {
+	names := set.Names()
+	if len(names) == 0 {
+		return nil, fmt.Errorf("goenumbson: cannot tell the enum type of an empty set")
+	}
+	enum, _ := set.EnumByName(names[0])
+	return &Codec[EC, VW, DC, VR]{set: set, enumType: reflect.TypeOf(enum)}, nil
+}
+
+// Type returns the enum type the codec handles
+func (c *Codec[EC, VW, DC, VR]) Type() reflect.Type {
+	return c.enumType
+}
+
+// EncodeValue writes the name of the enum in v, or null for a zero enum
+func (c *Codec[EC, VW, DC, VR]) EncodeValue(ec EC, vw VW, v reflect.Value) error {
+	enum, ok := v.Interface().(goenum.Enum)
+	if !ok || goenum.Equal(enum, nil) {
+		return vw.WriteNull()
+	}
+	return vw.WriteString(enum.String())
+}
+
+// DecodeValue reads a name and stores the enum registered under it in v; null stores the
+// zero enum and unknown names fail with an *goenum.UnknownEnumError
+func (c *Codec[EC, VW, DC, VR]) DecodeValue(dc DC, vr VR, v reflect.Value) error {
+	if !v.CanSet() || v.Type() != c.enumType {
+		return fmt.Errorf("goenumbson: cannot decode into %s, the codec decodes %s", v.Type(), c.enumType)
+	}
+	name, err := vr.ReadString()
+	if err != nil {
+		if vr.ReadNull() == nil {
+			v.Set(reflect.Zero(c.enumType))
+			return nil
+		}
+		return err
+	}
+	enum, exists := c.set.EnumByName(name)
+	if !exists {
+		allowed := c.set.Names()
+		return &goenum.UnknownEnumError{Input: name, Allowed: allowed}
+	}
+	v.Set(reflect.ValueOf(enum))
+	return nil
+}
+
+// RegisterCodecs registers a codec for the enum type of each set on rb, a
+// *bsoncodec.RegistryBuilder, with RegisterTypeEncoder and RegisterTypeDecoder
+func RegisterCodecs[EC any, VW ValueWriter, DC any, VR ValueReader](rb interface{}, sets ...goenum.AnyEnumSet) error {
+	builder := reflect.ValueOf(rb)
+	registerEncoder := builder.MethodByName("RegisterTypeEncoder")
+	registerDecoder := builder.MethodByName("RegisterTypeDecoder")
+	if !registerEncoder.IsValid() || !registerDecoder.IsValid() {
+		return fmt.Errorf("goenumbson: %T is not a registry builder", rb)
+	}
+	for _, set := range sets {
+		codec, err := NewCodec[EC, VW, DC, VR](set)
+		if err != nil {
+			return err
+		}
+		for _, register := range []reflect.Value{registerEncoder, registerDecoder} {
+			arg := reflect.New(register.Type().In(1)).Elem()
+			if !reflect.TypeOf(codec).AssignableTo(arg.Type()) {
+				return fmt.Errorf("goenumbson: the codec does not implement %s", arg.Type())
+			}
+			arg.Set(reflect.ValueOf(codec))
+			register.Call([]reflect.Value{reflect.ValueOf(codec.enumType), arg})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package goenumbson
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+// The types below mirror the parts of the MongoDB driver's bsoncodec and bsonrw packages
+// the codecs are used with
+
+type encodeContext struct{}
+
+type decodeContext struct{}
+
+type valueWriter interface {
+	WriteString(s string) error
+	WriteNull() error
+}
+
+type valueReader interface {
+	ReadString() (string, error)
+	ReadNull() error
+}
+
+type valueEncoder interface {
+	EncodeValue(ec encodeContext, vw valueWriter, v reflect.Value) error
+}
+
+type valueDecoder interface {
+	DecodeValue(dc decodeContext, vr valueReader, v reflect.Value) error
+}
+
+type registryBuilder struct {
+	encoders map[reflect.Type]valueEncoder
+	decoders map[reflect.Type]valueDecoder
+}
+
+func (rb *registryBuilder) RegisterTypeEncoder(t reflect.Type, enc valueEncoder) *registryBuilder {
+	rb.encoders[t] = enc
+	return rb
+}
+
+func (rb *registryBuilder) RegisterTypeDecoder(t reflect.Type, dec valueDecoder) *registryBuilder {
+	rb.decoders[t] = dec
+	return rb
+}
+
+// document is a single BSON value that is a string or null
+type document struct {
+	value  string
+	isNull bool
+}
+
+func (d *document) WriteString(s string) error {
+	*d = document{value: s}
+	return nil
+}
+
+func (d *document) WriteNull() error {
+	*d = document{isNull: true}
+	return nil
+}
+
+func (d *document) ReadString() (string, error) {
+	if d.isNull {
+		return "", errors.New("value is null, not a string")
+	}
+	return d.value, nil
+}
+
+func (d *document) ReadNull() error {
+	if !d.isNull {
+		return errors.New("value is a string, not null")
+	}
+	return nil
+}
+
+type Status struct{ *goenum.EnumBase }
+
+var (
+	StatusActive = Status{goenum.NewEnumBase(1, "ACTIVE", "Active")}
+	StatusClosed = Status{goenum.NewEnumBase(2, "CLOSED", "Closed")}
+)
+
+func TestRegisterCodecs(t *testing.T) {
+	set := goenum.NewEnumSet[Status]()
+	set.Register(StatusActive)
+	set.Register(StatusClosed)
+	rb := &registryBuilder{encoders: map[reflect.Type]valueEncoder{}, decoders: map[reflect.Type]valueDecoder{}}
+	statusType := reflect.TypeOf(Status{})
+
+	err := RegisterCodecs[encodeContext, valueWriter, decodeContext, valueReader](rb, set)
+	assert.NoError(t, err)
+	if !assert.Contains(t, rb.encoders, statusType) || !assert.Contains(t, rb.decoders, statusType) {
+		return
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		doc := &document{}
+		assert.NoError(t, rb.encoders[statusType].EncodeValue(encodeContext{}, doc, reflect.ValueOf(StatusClosed)))
+		assert.Equal(t, "CLOSED", doc.value)
+
+		var decoded Status
+		assert.NoError(t, rb.decoders[statusType].DecodeValue(decodeContext{}, doc, reflect.ValueOf(&decoded).Elem()))
+		assert.Equal(t, StatusClosed, decoded)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		doc := &document{}
+		assert.NoError(t, rb.encoders[statusType].EncodeValue(encodeContext{}, doc, reflect.ValueOf(Status{})))
+		assert.True(t, doc.isNull)
+
+		decoded := StatusActive
+		assert.NoError(t, rb.decoders[statusType].DecodeValue(decodeContext{}, doc, reflect.ValueOf(&decoded).Elem()))
+		assert.Equal(t, Status{}, decoded)
+	})
+
+	t.Run("unknown names", func(t *testing.T) {
+		var decoded Status
+		err := rb.decoders[statusType].DecodeValue(decodeContext{}, &document{value: "ARCHIVED"}, reflect.ValueOf(&decoded).Elem())
+		assert.True(t, errors.Is(err, goenum.ErrUnknownEnum))
+	})
+
+	t.Run("invalid registrations", func(t *testing.T) {
+		err := RegisterCodecs[encodeContext, valueWriter, decodeContext, valueReader](rb, goenum.NewEnumSet[Status]())
+		assert.Error(t, err, "empty sets should be rejected")
+		err = RegisterCodecs[encodeContext, valueWriter, decodeContext, valueReader](struct{}{}, set)
+		assert.Error(t, err)
+	})
+}
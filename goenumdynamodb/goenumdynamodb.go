@@ -0,0 +1,257 @@
+// Package goenumdynamodb stores goenum enums in DynamoDB items written and read by the
+// AWS SDK v2 attributevalue package, resolving them against the set registered for their
+// type with goenum.RegisterSetOf when items are unmarshaled.
+//
+// It does not import the SDK. Attribute is instantiated with types.AttributeValue, which
+// gives it the methods of attributevalue.Marshaler and Unmarshaler, and the SDK's member
+// types are registered once with RegisterMembers:
+//
+//	err := goenumdynamodb.RegisterMembers(goenumdynamodb.Members[types.AttributeValue]{
+//		S: &types.AttributeValueMemberS{}, N: &types.AttributeValueMemberN{},
+//		BOOL: &types.AttributeValueMemberBOOL{}, NULL: &types.AttributeValueMemberNULL{},
+//		L: &types.AttributeValueMemberL{}, M: &types.AttributeValueMemberM{},
+//	})
+//
+//	type Order struct {
+//		ID     string
+//		Status goenumdynamodb.Attribute[Status, types.AttributeValue]
+//	}
+package goenumdynamodb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// Members holds a value of each member type of the SDK's attribute value interface AV,
+// e.g. &types.AttributeValueMemberS{} for S
+type Members[AV any] struct {
+	S, N, BOOL, NULL, L, M AV
+}
+
+// memberTypes are the pointer types of the members registered for an attribute value type
+type memberTypes struct {
+	s, n, boolean, null, list, object reflect.Type
+}
+
+var (
+	membersMu sync.RWMutex
+	members   = map[reflect.Type]*memberTypes{}
+)
+
+// RegisterMembers records the member types of AV used to build attributes. Each member must
+// be a pointer to a struct whose Value field holds a string for S and N, a bool for BOOL and
+// NULL, a []AV for L and a map[string]AV for M.
+func RegisterMembers[AV any](m Members[AV]) error {
+	avType := reflect.TypeOf((*AV)(nil)).Elem()
+	types := &memberTypes{}
+	for _, member := range []struct {
+		name  string
+		value AV
+		field reflect.Type
+		dst   *reflect.Type
+	}{
+		{"S", m.S, reflect.TypeOf(""), &types.s},
+		{"N", m.N, reflect.TypeOf(""), &types.n},
+		{"BOOL", m.BOOL, reflect.TypeOf(false), &types.boolean},
+		{"NULL", m.NULL, reflect.TypeOf(false), &types.null},
+		{"L", m.L, reflect.SliceOf(avType), &types.list},
+		{"M", m.M, reflect.MapOf(reflect.TypeOf(""), avType), &types.object},
+	} {
+		t := reflect.TypeOf(member.value)
+		if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+			return fmt.Errorf("goenumdynamodb: member %s must be a pointer to a struct, got %v", member.name, t)
+		}
+		field, ok := t.Elem().FieldByName("Value")
+		if !ok || field.Type != member.field {
+			return fmt.Errorf("goenumdynamodb: member %s (%v) needs a Value field of type %v", member.name, t, member.field)
+		}
+		*member.dst = t
+	}
+
+	membersMu.Lock()
+	defer membersMu.Unlock()
+	members[avType] = types
+	return nil
+}
+
+// membersOf returns the member types registered for AV
+func membersOf[AV any]() (*memberTypes, error) {
+	avType := reflect.TypeOf((*AV)(nil)).Elem()
+	membersMu.RLock()
+	defer membersMu.RUnlock()
+	types, exists := members[avType]
+	if !exists {
+		return nil, fmt.Errorf("goenumdynamodb: no members registered for %v, call RegisterMembers", avType)
+	}
+	return types, nil
+}
+
+// Attribute holds an enum of type T in a DynamoDB item, stored in the enum's JSON format:
+// a name as S, a numeric value as N, the full format as M. An invalid Attribute is NULL.
+// AV is the SDK's types.AttributeValue.
+type Attribute[T goenum.Enum, AV any] struct {
+	Enum  T
+	Valid bool // Valid is true if Enum is not NULL
+}
+
+// NewAttribute returns a valid Attribute holding enum, e.g.
+// goenumdynamodb.NewAttribute[types.AttributeValue](StatusActive)
+func NewAttribute[AV any, T goenum.Enum](enum T) Attribute[T, AV] {
+	return Attribute[T, AV]{Enum: enum, Valid: true}
+}
+
+// MarshalDynamoDBAttributeValue implements attributevalue.Marshaler
+func (a Attribute[T, AV]) MarshalDynamoDBAttributeValue() (AV, error) {
+	var zero AV
+	types, err := membersOf[AV]()
+	if err != nil {
+		return zero, err
+	}
+
+	var value interface{}
+	if a.Valid {
+		data, err := json.Marshal(a.Enum)
+		if err != nil {
+			return zero, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return zero, err
+		}
+	}
+	av, err := types.attribute(value)
+	if err != nil {
+		return zero, err
+	}
+	return av.Interface().(AV), nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements attributevalue.Unmarshaler; NULL produces an
+// invalid Attribute
+func (a *Attribute[T, AV]) UnmarshalDynamoDBAttributeValue(av AV) error {
+	types, err := membersOf[AV]()
+	if err != nil {
+		return err
+	}
+	value, err := types.jsonValue(reflect.ValueOf(&av).Elem())
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		*a = Attribute[T, AV]{}
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var null goenum.Null[T]
+	if err := null.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*a = Attribute[T, AV]{Enum: null.Enum, Valid: null.Valid}
+	return nil
+}
+
+// attribute converts a decoded JSON value to a member
+func (m *memberTypes) attribute(value interface{}) (reflect.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return newMember(m.null, reflect.ValueOf(true)), nil
+	case string:
+		return newMember(m.s, reflect.ValueOf(v)), nil
+	case json.Number:
+		return newMember(m.n, reflect.ValueOf(v.String())), nil
+	case bool:
+		return newMember(m.boolean, reflect.ValueOf(v)), nil
+	case []interface{}:
+		list := reflect.MakeSlice(valueType(m.list), len(v), len(v))
+		for i, item := range v {
+			av, err := m.attribute(item)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			list.Index(i).Set(av)
+		}
+		return newMember(m.list, list), nil
+	case map[string]interface{}:
+		object := reflect.MakeMapWithSize(valueType(m.object), len(v))
+		for key, item := range v {
+			av, err := m.attribute(item)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			object.SetMapIndex(reflect.ValueOf(key), av)
+		}
+		return newMember(m.object, object), nil
+	}
+	return reflect.Value{}, fmt.Errorf("goenumdynamodb: cannot encode %T as a DynamoDB attribute", value)
+}
+
+// jsonValue converts a member to a value encoding to the same JSON
+func (m *memberTypes) jsonValue(av reflect.Value) (interface{}, error) {
+	if av.Kind() == reflect.Interface {
+		av = av.Elem()
+	}
+	if !av.IsValid() || av.Kind() == reflect.Pointer && av.IsNil() {
+		return nil, fmt.Errorf("goenumdynamodb: empty DynamoDB attribute")
+	}
+
+	var value reflect.Value
+	if av.Kind() == reflect.Pointer && av.Elem().Kind() == reflect.Struct {
+		value = av.Elem().FieldByName("Value")
+	}
+	switch av.Type() {
+	case m.s:
+		return value.String(), nil
+	case m.n:
+		return json.Number(value.String()), nil
+	case m.boolean:
+		return value.Bool(), nil
+	case m.null:
+		return nil, nil
+	case m.list:
+		list := make([]interface{}, value.Len())
+		for i := range list {
+			item, err := m.jsonValue(value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = item
+		}
+		return list, nil
+	case m.object:
+		object := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			item, err := m.jsonValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			object[iter.Key().String()] = item
+		}
+		return object, nil
+	}
+	return nil, fmt.Errorf("goenumdynamodb: unsupported DynamoDB attribute %v", av.Type())
+}
+
+// newMember allocates a member of the pointer type t holding value
+func newMember(t reflect.Type, value reflect.Value) reflect.Value {
+	member := reflect.New(t.Elem())
+	member.Elem().FieldByName("Value").Set(value)
+	return member
+}
+
+// valueType returns the type of the Value field of the member pointer type t
+func valueType(t reflect.Type) reflect.Type {
+	field, _ := t.Elem().FieldByName("Value")
+	return field.Type
+}
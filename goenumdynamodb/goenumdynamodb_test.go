@@ -0,0 +1,135 @@
+package goenumdynamodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+// The types below mirror the parts of the AWS SDK v2 types and attributevalue packages
+// Attribute is used with
+
+type attributeValue interface {
+	isAttributeValue()
+}
+
+type memberS struct{ Value string }
+
+type memberN struct{ Value string }
+
+type memberBOOL struct{ Value bool }
+
+type memberNULL struct{ Value bool }
+
+type memberL struct{ Value []attributeValue }
+
+type memberM struct{ Value map[string]attributeValue }
+
+func (*memberS) isAttributeValue()    {}
+func (*memberN) isAttributeValue()    {}
+func (*memberBOOL) isAttributeValue() {}
+func (*memberNULL) isAttributeValue() {}
+func (*memberL) isAttributeValue()    {}
+func (*memberM) isAttributeValue()    {}
+
+type marshaler interface {
+	MarshalDynamoDBAttributeValue() (attributeValue, error)
+}
+
+type unmarshaler interface {
+	UnmarshalDynamoDBAttributeValue(attributeValue) error
+}
+
+type Status struct{ *goenum.EnumBase }
+
+var (
+	StatusActive = Status{goenum.NewEnumBase(1, "ACTIVE", "Active", "ON")}
+	StatusClosed = Status{goenum.NewEnumBase(2, "CLOSED", "Closed")}
+	statusSet    = goenum.NewEnumSet[Status]().Register(StatusActive).Register(StatusClosed)
+)
+
+// registerStatuses registers the status set with config for the duration of the test
+func registerStatuses(t *testing.T, config *goenum.EnumJSONConfig) {
+	statusSet.SetJSONConfig(config)
+	goenum.RegisterSetOf(statusSet)
+	t.Cleanup(func() {
+		goenum.UnregisterSet("Status")
+		statusSet.SetJSONConfig(nil)
+	})
+}
+
+func TestAttribute(t *testing.T) {
+	assert.NoError(t, RegisterMembers(Members[attributeValue]{
+		S: &memberS{}, N: &memberN{}, BOOL: &memberBOOL{}, NULL: &memberNULL{}, L: &memberL{}, M: &memberM{},
+	}))
+	var _ marshaler = Attribute[Status, attributeValue]{}
+	var _ unmarshaler = &Attribute[Status, attributeValue]{}
+
+	t.Run("by name", func(t *testing.T) {
+		registerStatuses(t, nil)
+		av, err := NewAttribute[attributeValue](StatusActive).MarshalDynamoDBAttributeValue()
+		assert.NoError(t, err)
+		assert.Equal(t, &memberS{Value: "ACTIVE"}, av)
+
+		var decoded Attribute[Status, attributeValue]
+		assert.NoError(t, decoded.UnmarshalDynamoDBAttributeValue(&memberS{Value: "on"}))
+		assert.True(t, decoded.Valid)
+		assert.Same(t, StatusActive.EnumBase, decoded.Enum.EnumBase, "aliases should resolve to the registered enum")
+	})
+
+	t.Run("by value", func(t *testing.T) {
+		registerStatuses(t, &goenum.EnumJSONConfig{Format: goenum.JSONFormatValue})
+		av, err := NewAttribute[attributeValue](StatusClosed).MarshalDynamoDBAttributeValue()
+		assert.NoError(t, err)
+		assert.Equal(t, &memberN{Value: "2"}, av)
+
+		var decoded Attribute[Status, attributeValue]
+		assert.NoError(t, decoded.UnmarshalDynamoDBAttributeValue(av))
+		assert.Equal(t, StatusClosed, decoded.Enum)
+	})
+
+	t.Run("full format", func(t *testing.T) {
+		registerStatuses(t, &goenum.EnumJSONConfig{Format: goenum.JSONFormatFull})
+		av, err := NewAttribute[attributeValue](StatusActive).MarshalDynamoDBAttributeValue()
+		assert.NoError(t, err)
+		m, ok := av.(*memberM)
+		if !assert.True(t, ok, "the full format should be stored as M") {
+			return
+		}
+		assert.Equal(t, &memberS{Value: "ACTIVE"}, m.Value["name"])
+		assert.Equal(t, &memberL{Value: []attributeValue{&memberS{Value: "ON"}}}, m.Value["aliases"])
+
+		var decoded Attribute[Status, attributeValue]
+		assert.NoError(t, decoded.UnmarshalDynamoDBAttributeValue(av))
+		assert.Equal(t, StatusActive, decoded.Enum)
+	})
+
+	t.Run("NULL and unknown values", func(t *testing.T) {
+		registerStatuses(t, nil)
+		av, err := Attribute[Status, attributeValue]{}.MarshalDynamoDBAttributeValue()
+		assert.NoError(t, err)
+		assert.Equal(t, &memberNULL{Value: true}, av)
+
+		decoded := NewAttribute[attributeValue](StatusActive)
+		assert.NoError(t, decoded.UnmarshalDynamoDBAttributeValue(&memberNULL{Value: true}))
+		assert.False(t, decoded.Valid)
+
+		err = decoded.UnmarshalDynamoDBAttributeValue(&memberS{Value: "ARCHIVED"})
+		assert.True(t, errors.Is(err, goenum.ErrUnknownEnum))
+		assert.Error(t, decoded.UnmarshalDynamoDBAttributeValue(nil))
+	})
+}
+
+func TestRegisterMembers(t *testing.T) {
+	type otherValue interface{}
+	err := RegisterMembers(Members[otherValue]{S: "S", N: &memberN{}, BOOL: &memberBOOL{}, NULL: &memberNULL{}, L: &memberL{}, M: &memberM{}})
+	assert.ErrorContains(t, err, "member S must be a pointer to a struct")
+
+	err = RegisterMembers(Members[otherValue]{S: &memberS{}, N: &memberN{}, BOOL: &memberBOOL{}, NULL: &memberNULL{}, L: &memberL{}, M: &memberM{}})
+	assert.ErrorContains(t, err, "member L", "L must hold a slice of the attribute value type")
+
+	_, err = Attribute[Status, otherValue]{}.MarshalDynamoDBAttributeValue()
+	assert.ErrorContains(t, err, "call RegisterMembers")
+}
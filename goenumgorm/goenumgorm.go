@@ -0,0 +1,203 @@
+// Package goenumgorm stores goenum enums in GORM models through a serializer and derives
+// CHECK constraints for their columns from the enum sets.
+//
+// It does not import GORM: Register takes schema.RegisterSerializer and the serializer is
+// instantiated with *schema.Field, whose exported fields it reads by reflection.
+//
+//	goenumgorm.Register[*schema.Field](schema.RegisterSerializer)
+//
+//	type Order struct {
+//		ID     uint
+//		Status Status `gorm:"serializer:goenum"`       // stored by name
+//		Tier   Tier   `gorm:"serializer:goenum_value"` // stored by value
+//	}
+package goenumgorm
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// Storage selects what is stored in an enum column
+type Storage int
+
+const (
+	// StoreName stores the name of the enum
+	StoreName Storage = iota
+	// StoreValue stores the value of the enum
+	StoreValue
+)
+
+// Serializer names registered by Register, used in gorm:"serializer:..." tags
+const (
+	SerializerName  = "goenum"
+	SerializerValue = "goenum_value"
+)
+
+// Serializer implements GORM's SerializerInterface for the field type F, *schema.Field.
+// It resolves column values against the set whose enums have the type of the field.
+type Serializer[F any] struct {
+	storage Storage
+	sets    []goenum.AnyEnumSet
+}
+
+// NewSerializer creates a serializer storing enums as selected by storage, looking sets up
+// among sets, or in the global registry if none are given
+func NewSerializer[F any](storage Storage, sets ...goenum.AnyEnumSet) *Serializer[F] {
+	return &Serializer[F]{storage: storage, sets: sets}
+}
+
+// Register registers serializers storing names as "goenum" and values as "goenum_value"
+// with register, which is schema.RegisterSerializer. Sets are looked up among sets, or in
+// the global registry if none are given.
+func Register[F any, S any](register func(name string, serializer S), sets ...goenum.AnyEnumSet) {
+	register(SerializerName, any(NewSerializer[F](StoreName, sets...)).(S))
+	register(SerializerValue, any(NewSerializer[F](StoreValue, sets...)).(S))
+}
+
+// Scan resolves dbValue against the set of the field and assigns the enum to the field
+// of dst; NULL assigns the zero enum
+func (s *Serializer[F]) Scan(ctx context.Context, field F, dst reflect.Value, dbValue interface{}) error {
+	fieldType, ok := fieldOf(field, "FieldType").Interface().(reflect.Type)
+	if !ok {
+		return fmt.Errorf("goenumgorm: %T is not a GORM schema field", field)
+	}
+	value := reflect.Zero(fieldType)
+	if dbValue != nil {
+		set, err := s.setFor(fieldType)
+		if err != nil {
+			return err
+		}
+		enum, err := s.resolve(set, dbValue)
+		if err != nil {
+			return err
+		}
+		value = reflect.ValueOf(enum)
+	}
+
+	assign := fieldOf(field, "Set")
+	if assign.Kind() != reflect.Func || assign.IsNil() {
+		return fmt.Errorf("goenumgorm: %T is not a GORM schema field", field)
+	}
+	arg := reflect.New(assign.Type().In(2)).Elem()
+	arg.Set(value)
+	results := assign.Call([]reflect.Value{reflect.ValueOf(&ctx).Elem(), reflect.ValueOf(dst), arg})
+	if err, _ := results[0].Interface().(error); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Value returns the name or value of the enum in fieldValue, or NULL for a zero enum
+func (s *Serializer[F]) Value(ctx context.Context, field F, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	enum, ok := fieldValue.(goenum.Enum)
+	if !ok || goenum.Equal(enum, nil) {
+		return nil, nil
+	}
+	if s.storage == StoreName {
+		return enum.String(), nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(enum.Value())
+}
+
+// resolve finds the enum a column value stands for
+func (s *Serializer[F]) resolve(set goenum.AnyEnumSet, dbValue interface{}) (goenum.Enum, error) {
+	if b, ok := dbValue.([]byte); ok {
+		dbValue = string(b)
+	}
+	if s.storage == StoreName {
+		if enum, exists := set.EnumByName(fmt.Sprint(dbValue)); exists {
+			return enum, nil
+		}
+	} else {
+		// Drivers return integers as int64
+		candidates := []interface{}{dbValue}
+		if i, ok := dbValue.(int64); ok {
+			candidates = append(candidates, int(i))
+		}
+		for _, candidate := range candidates {
+			if enum, exists := set.EnumByValue(candidate); exists {
+				return enum, nil
+			}
+		}
+	}
+	allowed := set.Names()
+	return nil, &goenum.UnknownEnumError{Input: fmt.Sprint(dbValue), Allowed: allowed}
+}
+
+// setFor returns the set whose enums have type t
+func (s *Serializer[F]) setFor(t reflect.Type) (goenum.AnyEnumSet, error) {
+	sets := s.sets
+	if len(sets) == 0 {
+		for _, name := range goenum.SetNames() {
+			if set, exists := goenum.LookupSet(name); exists {
+				sets = append(sets, set)
+			}
+		}
+	}
+	for _, set := range sets {
+		names := set.Names()
+		if len(names) == 0 {
+			continue
+		}
+		if enum, exists := set.EnumByName(names[0]); exists && reflect.TypeOf(enum) == t {
+			return set, nil
+		}
+	}
+	return nil, fmt.Errorf("goenumgorm: no enum set holds %s enums", t)
+}
+
+// fieldOf returns the exported field name of the struct field points to
+func fieldOf(field interface{}, name string) reflect.Value {
+	v := reflect.ValueOf(field)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	return v.FieldByName(name)
+}
+
+// CheckExpression returns a SQL expression restricting column to the names or values of
+// the enums in set, e.g. "status IN ('ACTIVE', 'CLOSED')", in registration order
+func CheckExpression(column string, set goenum.AnyEnumSet, storage Storage) string {
+	names := set.Names()
+	literals := make([]string, 0, len(names))
+	for _, name := range names {
+		enum, exists := set.EnumByName(name)
+		if !exists {
+			continue
+		}
+		if storage == StoreName {
+			literals = append(literals, sqlLiteral(enum.String()))
+		} else {
+			literals = append(literals, sqlLiteral(enum.Value()))
+		}
+	}
+	return fmt.Sprintf("%s IN (%s)", column, strings.Join(literals, ", "))
+}
+
+// CheckConstraint returns the statement adding a CHECK constraint named chk_<table>_<column>
+// to run after AutoMigrate, e.g. db.Exec(goenumgorm.CheckConstraint("orders", "status",
+// StatusEnumSet, goenumgorm.StoreName))
+func CheckConstraint(table, column string, set goenum.AnyEnumSet, storage Storage) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT chk_%s_%s CHECK (%s)",
+		table, table, column, CheckExpression(column, set, storage))
+}
+
+// sqlLiteral formats a value as a SQL literal, quoting strings and anything not numeric
+func sqlLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	}
+	return "'" + strings.ReplaceAll(fmt.Sprint(value), "'", "''") + "'"
+}
@@ -0,0 +1,115 @@
+package goenumgorm
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+// schemaField mirrors the fields of GORM's schema.Field the serializer uses
+type schemaField struct {
+	FieldType reflect.Type
+	Set       func(ctx context.Context, dst reflect.Value, value interface{}) error
+}
+
+// serializerInterface mirrors GORM's schema.SerializerInterface
+type serializerInterface interface {
+	Scan(ctx context.Context, field *schemaField, dst reflect.Value, dbValue interface{}) error
+	Value(ctx context.Context, field *schemaField, dst reflect.Value, fieldValue interface{}) (interface{}, error)
+}
+
+type Status struct{ *goenum.EnumBase }
+
+type order struct {
+	Status Status
+}
+
+var (
+	StatusActive = Status{goenum.NewEnumBase(1, "ACTIVE", "Active")}
+	StatusClosed = Status{goenum.NewEnumBase(2, "CLOSED", "It's closed")}
+)
+
+func newStatusSet() *goenum.EnumSet[Status] {
+	set := goenum.NewEnumSet[Status]()
+	set.Register(StatusActive)
+	set.Register(StatusClosed)
+	return set
+}
+
+func statusField() *schemaField {
+	return &schemaField{
+		FieldType: reflect.TypeOf(Status{}),
+		Set: func(ctx context.Context, dst reflect.Value, value interface{}) error {
+			dst.Elem().FieldByName("Status").Set(reflect.ValueOf(value))
+			return nil
+		},
+	}
+}
+
+func TestSerializer(t *testing.T) {
+	set := newStatusSet()
+	serializers := map[string]serializerInterface{}
+	Register[*schemaField](func(name string, serializer serializerInterface) {
+		serializers[name] = serializer
+	}, set)
+
+	t.Run("by name", func(t *testing.T) {
+		serializer := serializers[SerializerName]
+		var o order
+		assert.NoError(t, serializer.Scan(context.Background(), statusField(), reflect.ValueOf(&o), []byte("CLOSED")))
+		assert.Equal(t, StatusClosed, o.Status)
+
+		value, err := serializer.Value(context.Background(), statusField(), reflect.ValueOf(&o), o.Status)
+		assert.NoError(t, err)
+		assert.Equal(t, "CLOSED", value)
+	})
+
+	t.Run("by value", func(t *testing.T) {
+		serializer := serializers[SerializerValue]
+		var o order
+		assert.NoError(t, serializer.Scan(context.Background(), statusField(), reflect.ValueOf(&o), int64(1)))
+		assert.Equal(t, StatusActive, o.Status)
+
+		value, err := serializer.Value(context.Background(), statusField(), reflect.ValueOf(&o), o.Status)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), value)
+	})
+
+	t.Run("NULL and unknown values", func(t *testing.T) {
+		serializer := serializers[SerializerName]
+		o := order{Status: StatusActive}
+		assert.NoError(t, serializer.Scan(context.Background(), statusField(), reflect.ValueOf(&o), nil))
+		assert.Equal(t, Status{}, o.Status)
+
+		value, err := serializer.Value(context.Background(), statusField(), reflect.ValueOf(&o), o.Status)
+		assert.NoError(t, err)
+		assert.Nil(t, value, "zero enums should be stored as NULL")
+
+		err = serializer.Scan(context.Background(), statusField(), reflect.ValueOf(&o), "ARCHIVED")
+		assert.True(t, errors.Is(err, goenum.ErrUnknownEnum))
+	})
+
+	t.Run("global registry", func(t *testing.T) {
+		goenum.RegisterSetOf(set)
+		t.Cleanup(func() { goenum.UnregisterSet("Status") })
+		var o order
+		assert.NoError(t, NewSerializer[*schemaField](StoreName).Scan(context.Background(), statusField(), reflect.ValueOf(&o), "ACTIVE"))
+		assert.Equal(t, StatusActive, o.Status)
+	})
+}
+
+func TestCheckConstraint(t *testing.T) {
+	set := newStatusSet()
+	assert.Equal(t, "status IN ('ACTIVE', 'CLOSED')", CheckExpression("status", set, StoreName))
+	assert.Equal(t, "status IN (1, 2)", CheckExpression("status", set, StoreValue))
+	assert.Equal(t, "ALTER TABLE orders ADD CONSTRAINT chk_orders_status CHECK (status IN ('ACTIVE', 'CLOSED'))",
+		CheckConstraint("orders", "status", set, StoreName))
+
+	quoted := goenum.NewEnumSet[*goenum.EnumBase]()
+	quoted.Register(goenum.NewEnumBase("it's", "QUOTED", "Quoted"))
+	assert.Equal(t, "code IN ('it''s')", CheckExpression("code", quoted, StoreValue))
+}
@@ -0,0 +1,228 @@
+// Package goenumpgx maps goenum sets onto Postgres enum types (CREATE TYPE ... AS ENUM)
+// for pgx v5, storing enums by name.
+//
+// It does not import pgx. Postgres sends enum labels as the same bytes in the text and
+// binary formats. RegisterSet installs a Codec, instantiated with pgx's map and plan
+// types, on the loaded type's OID, so enum columns scan straight into enums:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		return goenumpgx.RegisterSet[*pgtype.Type, *pgtype.Map, pgtype.EncodePlan, pgtype.ScanPlan](
+//			ctx, conn, "status", StatusEnumSet)
+//	}
+//
+//	var status Status
+//	err := conn.QueryRow(ctx, "SELECT status FROM orders WHERE id = $1", id).Scan(&status)
+//	_, err = conn.Exec(ctx, "UPDATE orders SET status = $1", status)
+//
+// Types registered with RegisterTypes keep pgx's own enum codec, which scans into
+// sql.Scanner targets and encodes driver.Valuer values, so Scanner and Valuer work there.
+package goenumpgx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// Conn is the part of *pgx.Conn RegisterTypes uses, for type T *pgtype.Type and type map
+// M *pgtype.Map
+type Conn[T any, M TypeMap[T]] interface {
+	LoadType(ctx context.Context, typeName string) (T, error)
+	TypeMap() M
+}
+
+// TypeMap is the part of *pgtype.Map RegisterTypes uses
+type TypeMap[T any] interface {
+	RegisterType(t T)
+}
+
+// RegisterTypes loads the Postgres enum types named typeNames and registers them on the
+// connection, typically in pgxpool's AfterConnect, so pgx encodes and decodes their
+// columns with its enum codec in the binary protocol
+func RegisterTypes[T any, M TypeMap[T]](ctx context.Context, conn Conn[T, M], typeNames ...string) error {
+	for _, typeName := range typeNames {
+		t, err := conn.LoadType(ctx, typeName)
+		if err != nil {
+			return fmt.Errorf("goenumpgx: load type %s: %w", typeName, err)
+		}
+		conn.TypeMap().RegisterType(t)
+	}
+	return nil
+}
+
+// RegisterSet loads the Postgres enum type typeName, replaces its codec with a Codec for
+// set and registers it on the connection, typically in pgxpool's AfterConnect. T is
+// *pgtype.Type, whose Codec field is set by reflection, M is *pgtype.Map and EP and SP are
+// pgtype.EncodePlan and pgtype.ScanPlan.
+func RegisterSet[T any, M TypeMap[T], EP any, SP any, E goenum.Enum](ctx context.Context, conn Conn[T, M], typeName string, set *goenum.EnumSet[E]) error {
+	t, err := conn.LoadType(ctx, typeName)
+	if err != nil {
+		return fmt.Errorf("goenumpgx: load type %s: %w", typeName, err)
+	}
+	codec := NewCodec[M, EP, SP](set)
+	field := reflect.ValueOf(t)
+	if field.Kind() == reflect.Pointer && !field.IsNil() && field.Elem().Kind() == reflect.Struct {
+		field = field.Elem().FieldByName("Codec")
+	} else {
+		field = reflect.Value{}
+	}
+	if !field.IsValid() || !field.CanSet() || !reflect.TypeOf(codec).AssignableTo(field.Type()) {
+		return fmt.Errorf("goenumpgx: %T has no Codec field accepting %T", t, codec)
+	}
+	field.Set(reflect.ValueOf(codec))
+	conn.TypeMap().RegisterType(t)
+	return nil
+}
+
+// Codec implements pgtype.Codec for the Postgres enum type of a set, for map type M
+// *pgtype.Map and plan types EP pgtype.EncodePlan and SP pgtype.ScanPlan. It encodes enums
+// of type E as their names, scans labels into *E resolving them against the set and
+// decodes labels to enums for rows.Values.
+type Codec[M any, EP any, SP any, E goenum.Enum] struct {
+	set *goenum.EnumSet[E]
+}
+
+// NewCodec creates a Codec for set
+func NewCodec[M any, EP any, SP any, E goenum.Enum](set *goenum.EnumSet[E]) *Codec[M, EP, SP, E] {
+	return &Codec[M, EP, SP, E]{set: set}
+}
+
+// FormatSupported implements pgtype.Codec; labels are the same in both formats
+func (c *Codec[M, EP, SP, E]) FormatSupported(format int16) bool {
+	return format == 0 || format == 1
+}
+
+// PreferredFormat implements pgtype.Codec, preferring the text format
+func (c *Codec[M, EP, SP, E]) PreferredFormat() int16 {
+	return 0
+}
+
+// PlanEncode implements pgtype.Codec for values of type E, returning nil for others
+func (c *Codec[M, EP, SP, E]) PlanEncode(m M, oid uint32, format int16, value any) EP {
+	var plan EP
+	if _, ok := value.(E); ok {
+		plan, _ = any(encodePlan[E]{}).(EP)
+	}
+	return plan
+}
+
+// PlanScan implements pgtype.Codec for targets of type *E, returning nil for others
+func (c *Codec[M, EP, SP, E]) PlanScan(m M, oid uint32, format int16, target any) SP {
+	var plan SP
+	if _, ok := target.(*E); ok {
+		plan, _ = any(scanPlan[E]{set: c.set}).(SP)
+	}
+	return plan
+}
+
+// DecodeDatabaseSQLValue implements pgtype.Codec, returning the label
+func (c *Codec[M, EP, SP, E]) DecodeDatabaseSQLValue(m M, oid uint32, format int16, src []byte) (driver.Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+	return string(src), nil
+}
+
+// DecodeValue implements pgtype.Codec, resolving the label to an enum of type E
+func (c *Codec[M, EP, SP, E]) DecodeValue(m M, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+	return c.set.GetByNameE(string(src))
+}
+
+// encodePlan implements pgtype.EncodePlan for enums of type E
+type encodePlan[E goenum.Enum] struct{}
+
+// Encode appends the name of the enum to buf; a nil enum is NULL
+func (encodePlan[E]) Encode(value any, buf []byte) ([]byte, error) {
+	enum := value.(E)
+	if goenum.Equal(enum, nil) {
+		return nil, nil
+	}
+	return append(buf, enum.String()...), nil
+}
+
+// scanPlan implements pgtype.ScanPlan into *E
+type scanPlan[E goenum.Enum] struct {
+	set *goenum.EnumSet[E]
+}
+
+// Scan resolves the label in src and stores the enum in target; NULL stores the zero enum
+func (p scanPlan[E]) Scan(src []byte, target any) error {
+	if src == nil {
+		return Scanner(target.(*E), p.set).Scan(nil)
+	}
+	return Scanner(target.(*E), p.set).Scan(src)
+}
+
+// Scanner returns a sql.Scanner resolving the label of a Postgres enum against set and
+// storing the enum in dst. NULL stores the zero enum; unknown labels fail with an
+// *goenum.UnknownEnumError.
+func Scanner[T goenum.Enum](dst *T, set *goenum.EnumSet[T]) sql.Scanner {
+	return &scanner[T]{dst: dst, set: set}
+}
+
+// scanner is the sql.Scanner returned by Scanner
+type scanner[T goenum.Enum] struct {
+	dst *T
+	set *goenum.EnumSet[T]
+}
+
+// Scan implements sql.Scanner for labels in the text or binary format
+func (s *scanner[T]) Scan(src interface{}) error {
+	var label string
+	switch v := src.(type) {
+	case nil:
+		var zero T
+		*s.dst = zero
+		return nil
+	case string:
+		label = v
+	case []byte:
+		label = string(v)
+	default:
+		return fmt.Errorf("goenumpgx: cannot scan %T into an enum", src)
+	}
+	enum, err := s.set.GetByNameE(label)
+	if err != nil {
+		return err
+	}
+	*s.dst = enum
+	return nil
+}
+
+// Valuer returns a driver.Valuer encoding enum as its name, the label of its Postgres
+// enum value, or NULL for a nil enum
+func Valuer(enum goenum.Enum) driver.Valuer {
+	return valuer{enum: enum}
+}
+
+// valuer is the driver.Valuer returned by Valuer
+type valuer struct {
+	enum goenum.Enum
+}
+
+// Value implements driver.Valuer
+func (v valuer) Value() (driver.Value, error) {
+	if goenum.Equal(v.enum, nil) {
+		return nil, nil
+	}
+	return v.enum.String(), nil
+}
+
+// CreateType returns the statement creating a Postgres enum type with the names of the
+// enums in set as labels, in registration order, which is the sort order of the type
+func CreateType[T goenum.Enum](typeName string, set *goenum.EnumSet[T]) string {
+	enums := set.Values()
+	labels := make([]string, 0, len(enums))
+	for _, enum := range enums {
+		labels = append(labels, "'"+strings.ReplaceAll(enum.String(), "'", "''")+"'")
+	}
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", typeName, strings.Join(labels, ", "))
+}
@@ -0,0 +1,170 @@
+package goenumpgx
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+// pgType, typeMap, pgCodec, pgEncodePlan and pgScanPlan mirror *pgtype.Type, *pgtype.Map,
+// pgtype.Codec, pgtype.EncodePlan and pgtype.ScanPlan
+type pgType struct {
+	Codec pgCodec
+	Name  string
+	OID   uint32
+}
+
+type typeMap struct {
+	registered []string
+	types      map[string]*pgType
+}
+
+func (m *typeMap) RegisterType(t *pgType) {
+	m.registered = append(m.registered, t.Name)
+	if m.types == nil {
+		m.types = make(map[string]*pgType)
+	}
+	m.types[t.Name] = t
+}
+
+type pgCodec interface {
+	FormatSupported(format int16) bool
+	PreferredFormat() int16
+	PlanEncode(m *typeMap, oid uint32, format int16, value any) pgEncodePlan
+	PlanScan(m *typeMap, oid uint32, format int16, target any) pgScanPlan
+	DecodeDatabaseSQLValue(m *typeMap, oid uint32, format int16, src []byte) (driver.Value, error)
+	DecodeValue(m *typeMap, oid uint32, format int16, src []byte) (any, error)
+}
+
+type pgEncodePlan interface {
+	Encode(value any, buf []byte) (newBuf []byte, err error)
+}
+
+type pgScanPlan interface {
+	Scan(src []byte, target any) error
+}
+
+// conn mirrors *pgx.Conn
+type conn struct{ types *typeMap }
+
+func (c *conn) LoadType(ctx context.Context, typeName string) (*pgType, error) {
+	if typeName == "missing" {
+		return nil, errors.New("type not found")
+	}
+	return &pgType{Name: typeName, OID: 16385}, nil
+}
+
+func (c *conn) TypeMap() *typeMap {
+	return c.types
+}
+
+type Status struct{ *goenum.EnumBase }
+
+var (
+	StatusActive = Status{goenum.NewEnumBase(1, "ACTIVE", "Active")}
+	StatusClosed = Status{goenum.NewEnumBase(2, "CLOSED", "Closed")}
+)
+
+func newStatusSet() *goenum.EnumSet[Status] {
+	set := goenum.NewEnumSet[Status]()
+	set.Register(StatusActive)
+	set.Register(StatusClosed)
+	return set
+}
+
+func TestRegisterTypes(t *testing.T) {
+	c := &conn{types: &typeMap{}}
+	assert.NoError(t, RegisterTypes[*pgType, *typeMap](context.Background(), c, "status", "tier"))
+	assert.Equal(t, []string{"status", "tier"}, c.types.registered)
+
+	err := RegisterTypes[*pgType, *typeMap](context.Background(), c, "missing")
+	assert.EqualError(t, err, "goenumpgx: load type missing: type not found")
+}
+
+func TestRegisterSet(t *testing.T) {
+	c := &conn{types: &typeMap{}}
+	set := newStatusSet()
+	assert.NoError(t, RegisterSet[*pgType, *typeMap, pgEncodePlan, pgScanPlan](context.Background(), c, "status", set))
+	registered := c.types.types["status"]
+	if !assert.NotNil(t, registered) || !assert.NotNil(t, registered.Codec, "the codec should be installed on the type") {
+		return
+	}
+	codec := registered.Codec
+	assert.True(t, codec.FormatSupported(1))
+
+	t.Run("scan", func(t *testing.T) {
+		var status Status
+		plan := codec.PlanScan(c.types, registered.OID, 1, &status)
+		if assert.NotNil(t, plan) {
+			assert.NoError(t, plan.Scan([]byte("CLOSED"), &status))
+			assert.Equal(t, StatusClosed, status)
+			assert.NoError(t, plan.Scan(nil, &status))
+			assert.Equal(t, Status{}, status)
+			assert.True(t, errors.Is(plan.Scan([]byte("ARCHIVED"), &status), goenum.ErrUnknownEnum))
+		}
+		var label string
+		assert.Nil(t, codec.PlanScan(c.types, registered.OID, 1, &label), "other targets are left to pgx")
+	})
+
+	t.Run("encode", func(t *testing.T) {
+		plan := codec.PlanEncode(c.types, registered.OID, 0, StatusActive)
+		if assert.NotNil(t, plan) {
+			buf, err := plan.Encode(StatusActive, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, "ACTIVE", string(buf))
+			buf, err = plan.Encode(Status{}, nil)
+			assert.NoError(t, err)
+			assert.Nil(t, buf, "zero enums should be encoded as NULL")
+		}
+		assert.Nil(t, codec.PlanEncode(c.types, registered.OID, 0, "ACTIVE"))
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		value, err := codec.DecodeValue(c.types, registered.OID, 0, []byte("ACTIVE"))
+		assert.NoError(t, err)
+		assert.Equal(t, StatusActive, value)
+		sqlValue, err := codec.DecodeDatabaseSQLValue(c.types, registered.OID, 0, []byte("ACTIVE"))
+		assert.NoError(t, err)
+		assert.Equal(t, "ACTIVE", sqlValue)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		err := RegisterSet[*pgType, *typeMap, pgEncodePlan, pgScanPlan](context.Background(), c, "missing", set)
+		assert.EqualError(t, err, "goenumpgx: load type missing: type not found")
+		err = RegisterSet[*pgType, *typeMap, pgScanPlan, pgEncodePlan](context.Background(), c, "status", set)
+		assert.ErrorContains(t, err, "has no Codec field", "codecs not implementing the field's interface should be rejected")
+	})
+}
+
+func TestScanner(t *testing.T) {
+	set := newStatusSet()
+	var status Status
+
+	assert.NoError(t, Scanner(&status, set).Scan("CLOSED"))
+	assert.Equal(t, StatusClosed, status)
+	assert.NoError(t, Scanner(&status, set).Scan([]byte("ACTIVE")), "binary labels should scan")
+	assert.Equal(t, StatusActive, status)
+	assert.NoError(t, Scanner(&status, set).Scan(nil))
+	assert.Equal(t, Status{}, status)
+
+	assert.True(t, errors.Is(Scanner(&status, set).Scan("ARCHIVED"), goenum.ErrUnknownEnum))
+	assert.Error(t, Scanner(&status, set).Scan(int64(1)))
+}
+
+func TestValuer(t *testing.T) {
+	value, err := Valuer(StatusClosed).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "CLOSED", value)
+
+	value, err = Valuer(Status{}).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestCreateType(t *testing.T) {
+	assert.Equal(t, "CREATE TYPE status AS ENUM ('ACTIVE', 'CLOSED')", CreateType("status", newStatusSet()))
+}
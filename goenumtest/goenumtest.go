@@ -0,0 +1,29 @@
+// Package goenumtest provides test helpers for goenum enum sets. It is kept apart from
+// goenum so that programs using enums don't link the testing package.
+package goenumtest
+
+import (
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+// FuzzUnmarshal seeds f with the set's corpus and fuzzes unmarshal with arbitrary input.
+// If unmarshal is nil, inputs are decoded into an EnumBase in every JSON format.
+func FuzzUnmarshal[T goenum.Enum](f *testing.F, set *goenum.EnumSet[T], unmarshal func(data []byte) error) {
+	for _, data := range set.FuzzCorpus() {
+		f.Add(data)
+	}
+	if unmarshal == nil {
+		unmarshal = func(data []byte) error {
+			for _, format := range []goenum.JSONFormat{goenum.JSONFormatName, goenum.JSONFormatValue, goenum.JSONFormatFull} {
+				base := goenum.NewEnumBase(nil, "", "")
+				_ = base.UnmarshalJSONWithConfig(data, &goenum.EnumJSONConfig{Format: format})
+			}
+			return nil
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_ = unmarshal(data)
+	})
+}
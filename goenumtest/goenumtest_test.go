@@ -0,0 +1,22 @@
+package goenumtest
+
+import (
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+)
+
+var statusSet = goenum.NewEnumSet[*goenum.EnumBase]().
+	Register(goenum.NewEnumBase(1, "ACTIVE", "Active", "ON")).
+	Register(goenum.NewEnumBase(2, "INACTIVE", "Inactive", "OFF"))
+
+func FuzzEnumBaseUnmarshal(f *testing.F) {
+	FuzzUnmarshal(f, statusSet, nil)
+}
+
+func FuzzParseJSON(f *testing.F) {
+	FuzzUnmarshal(f, statusSet, func(data []byte) error {
+		_, err := statusSet.ParseJSON(data)
+		return err
+	})
+}
@@ -0,0 +1,61 @@
+package goenum
+
+import "sort"
+
+// groupedEnum is implemented by enums that belong to a group
+type groupedEnum interface {
+	Group() string
+}
+
+// groupOf returns the group of an enum, or an empty string if it has none
+func groupOf(enum Enum) string {
+	if g, ok := enum.(groupedEnum); ok {
+		return g.Group()
+	}
+	return ""
+}
+
+// WithGroup assigns the enum to a group and returns the EnumBase for chaining
+func (e *EnumBase) WithGroup(group string) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	e.group = group
+	return e
+}
+
+// Group returns the group the enum belongs to
+func (e *EnumBase) Group() string {
+	if e == nil {
+		return ""
+	}
+	return e.group
+}
+
+// Group returns a new EnumSet containing only the enums in the given group, registered in
+// the order of this set
+func (es *EnumSet[T]) Group(group string) *EnumSet[T] {
+	result := NewEnumSet[T]()
+	for _, enum := range es.Values() {
+		if groupOf(enum) == group {
+			result.Register(enum)
+		}
+	}
+	return result
+}
+
+// Groups returns the sorted names of all groups used in the set
+func (es *EnumSet[T]) Groups() []string {
+	seen := make(map[string]bool)
+	groups := make([]string, 0)
+	for _, enum := range es.values {
+		group := groupOf(enum)
+		if group == "" || seen[group] {
+			continue
+		}
+		seen[group] = true
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
@@ -0,0 +1,77 @@
+package goenum
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumGroups(t *testing.T) {
+	var (
+		invoice = TestEnum{NewEnumBase(1, "INVOICE", "Invoice created").WithGroup("billing")}
+		refund  = TestEnum{NewEnumBase(2, "REFUND", "Refund issued").WithGroup("billing")}
+		login   = TestEnum{NewEnumBase(3, "LOGIN", "User logged in").WithGroup("auth")}
+		other   = TestEnum{NewEnumBase(4, "OTHER", "Ungrouped event")}
+	)
+	set := NewEnumSet[TestEnum]().Register(invoice).Register(refund).Register(login).Register(other)
+
+	t.Run("Group() accessor", func(t *testing.T) {
+		assert.Equal(t, "billing", invoice.Group(), "Group() should return assigned group")
+		assert.Equal(t, "", other.Group(), "Group() should return empty string for ungrouped enum")
+
+		var nilEnum TestEnum
+		assert.Equal(t, "", nilEnum.Group(), "Group() should return empty string for nil enum")
+	})
+
+	t.Run("Group() sub-set", func(t *testing.T) {
+		billing := set.Group("billing")
+		assert.ElementsMatch(t, []TestEnum{invoice, refund}, billing.Values(), "Group() should return enums in the group")
+
+		enum, exists := billing.GetByName("INVOICE")
+		assert.True(t, exists, "Group() result should support lookups")
+		assert.Equal(t, invoice, enum, "Group() result should return correct enum")
+
+		assert.Empty(t, set.Group("missing").Values(), "Group() should return empty set for unknown group")
+	})
+
+	t.Run("Groups() method", func(t *testing.T) {
+		assert.Equal(t, []string{"auth", "billing"}, set.Groups(), "Groups() should return sorted distinct groups")
+	})
+
+	t.Run("full JSON format", func(t *testing.T) {
+		enum := NewEnumBase(1, "INVOICE", "Invoice created").WithGroup("billing")
+		enum.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatFull})
+		data, err := json.Marshal(enum)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"INVOICE","value":1,"description":"Invoice created","group":"billing"}`, string(data))
+
+		decoded := &EnumBase{jsonConfig: &EnumJSONConfig{Format: JSONFormatFull}}
+		assert.NoError(t, json.Unmarshal(data, decoded))
+		assert.Equal(t, "billing", decoded.Group(), "UnmarshalJSON() should restore group")
+	})
+
+	t.Run("dynamic loading and export", func(t *testing.T) {
+		options := DefaultValidationOptions()
+		options.DuplicateHandling = DuplicateSkip
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromReader(strings.NewReader(`[{"name":"INVOICE","value":1,"description":"Invoice created","group":"billing"}]`))
+		assert.NoError(t, err)
+
+		enum, exists := loader.GetEnumSet().GetByName("INVOICE")
+		assert.True(t, exists)
+		assert.Equal(t, "billing", groupOf(enum), "loader should assign group from definition")
+
+		exportFile := filepath.Join(t.TempDir(), "export.json")
+		assert.NoError(t, loader.ExportToJSON(exportFile))
+		data, err := os.ReadFile(exportFile)
+		assert.NoError(t, err)
+
+		var exported []EnumDefinition
+		assert.NoError(t, json.Unmarshal(data, &exported))
+		assert.Equal(t, "billing", exported[0].Group, "ExportToJSON() should include group")
+	})
+}
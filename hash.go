@@ -0,0 +1,60 @@
+package goenum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+// sortedByName returns the enums of the set ordered by name
+func (es *EnumSet[T]) sortedByName() []T {
+	result := es.Values()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result
+}
+
+// Hash returns a deterministic hex-encoded SHA-256 digest of the names, values and aliases
+// of the set, so services can cheaply detect diverging catalogs (e.g. in handshake headers
+// or health checks). Registration order, alias order and case, and descriptions do not
+// affect the hash; values hash by their JSON encoding, so 1 and int64(1) are equal.
+func (es *EnumSet[T]) Hash() string {
+	h := sha256.New()
+	for _, enum := range es.sortedByName() {
+		value := encodedValue(enum.Value())
+		aliases := make([]string, 0, len(enum.Aliases()))
+		for _, alias := range enum.Aliases() {
+			aliases = append(aliases, strings.ToUpper(alias))
+		}
+		sort.Strings(aliases)
+		fmt.Fprintf(h, "%q %q %q\n", enum.String(), value, aliases)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Hash returns a stable 64-bit FNV-1a hash of the name and value of the enum, for custom
+// hash-based containers and consistent hashing. Like the set hash, values hash by their
+// JSON encoding, so enums that are Equal hash the same. A nil enum hashes to 0.
+func (e *EnumBase) Hash() uint64 {
+	if e == nil {
+		return 0
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q %q", e.name, encodedValue(e.value))
+	return h.Sum64()
+}
+
+// encodedValue returns the JSON encoding of an enum value, or its fmt representation
+// if it cannot be encoded
+func encodedValue(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
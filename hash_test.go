@@ -0,0 +1,49 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetHash(t *testing.T) {
+	build := func(enums ...*EnumBase) *EnumSet[*EnumBase] {
+		set := NewEnumSet[*EnumBase]()
+		for _, enum := range enums {
+			set.Register(enum)
+		}
+		return set
+	}
+
+	base := build(NewEnumBase(1, "A", "First", "ALPHA", "ONE"), NewEnumBase(2, "B", "Second"))
+	hash := base.Hash()
+
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Len(t, hash, 64, "Hash() should be a hex SHA-256 digest")
+		assert.Equal(t, hash, base.Hash(), "Hash() should be stable")
+	})
+
+	t.Run("ignores order, alias case and descriptions", func(t *testing.T) {
+		same := build(NewEnumBase(2, "B", "Other description"), NewEnumBase(int64(1), "A", "First", "one", "alpha"))
+		assert.Equal(t, hash, same.Hash())
+	})
+
+	t.Run("detects divergence", func(t *testing.T) {
+		assert.NotEqual(t, hash, build(NewEnumBase(1, "A", "First", "ALPHA", "ONE"), NewEnumBase(3, "B", "Second")).Hash(), "value changes should change the hash")
+		assert.NotEqual(t, hash, build(NewEnumBase(1, "A", "First", "ALPHA", "ONE"), NewEnumBase("2", "B", "Second")).Hash(), "value types should change the hash")
+		assert.NotEqual(t, hash, build(NewEnumBase(1, "A", "First", "ALPHA"), NewEnumBase(2, "B", "Second")).Hash(), "alias changes should change the hash")
+		assert.NotEqual(t, hash, build(NewEnumBase(1, "A", "First", "ALPHA", "ONE")).Hash(), "removals should change the hash")
+		assert.NotEqual(t, NewEnumSet[*EnumBase]().Hash(), hash)
+	})
+}
+
+func TestEnumHash(t *testing.T) {
+	hash := NewEnumBase(1, "ACTIVE", "Active").Hash()
+
+	assert.Equal(t, hash, NewEnumBase(1, "ACTIVE", "Described differently", "on").Hash(), "Hash() should only depend on name and value")
+	assert.Equal(t, hash, NewEnumBase(float64(1), "ACTIVE", "Active").Hash(), "equal enums should hash the same")
+	assert.NotEqual(t, hash, NewEnumBase(2, "ACTIVE", "Active").Hash())
+	assert.NotEqual(t, hash, NewEnumBase("1", "ACTIVE", "Active").Hash())
+	assert.NotEqual(t, hash, NewEnumBase(1, "CLOSED", "Active").Hash())
+	assert.Equal(t, uint64(0), (*EnumBase)(nil).Hash())
+}
@@ -0,0 +1,456 @@
+package goenum
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// HCLCodec decodes enum definitions written as HCL blocks, one per enum, labeled with
+// its name:
+//
+//	enum "ACTIVE" {
+//	  value        = 1
+//	  description  = "Active"
+//	  aliases      = ["on", "enabled"]
+//	  group        = "live"
+//	  display_name = "Active"
+//	  tags         = ["billing"]
+//	  meta         = { tier = "gold" }
+//	}
+//
+// It covers the literal subset of HCL definitions need: strings, decimal numbers,
+// booleans, null, lists and objects, with #, // and /* */ comments. Templates, heredocs,
+// expressions and hexadecimal numbers are not supported, and integers must fit an int.
+// A value that is a list or an object is rejected as a *DefinitionError locating it. Register it with RegisterLoaderFormat(".hcl", HCLCodec)
+// to load .hcl files from directories.
+var HCLCodec DefinitionCodec = DefinitionCodecFunc(decodeHCL)
+
+// LoadFromHCL loads enum definitions from an HCL file, see HCLCodec.
+// Rejected definitions are reported as *DefinitionError.
+func (l *DynamicEnumLoader) LoadFromHCL(filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	return withFile(l.loadFromCodec(file, HCLCodec, ".hcl"), filename)
+}
+
+// decodeHCL decodes the enum blocks of an HCL document
+func decodeHCL(reader io.Reader) ([]EnumDefinition, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	p := &hclParser{src: string(data), line: 1}
+	var definitions []EnumDefinition
+	for {
+		tok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == hclEOF {
+			return definitions, nil
+		}
+		if tok.kind != hclIdent || tok.text != "enum" {
+			return nil, p.errorf(tok, "expected an enum block, found %s", tok)
+		}
+		def, err := p.enumBlock(len(definitions))
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, def)
+	}
+}
+
+// hclTokenKind classifies HCL tokens
+type hclTokenKind int
+
+const (
+	hclEOF hclTokenKind = iota
+	hclIdent
+	hclString
+	hclNumber
+	hclPunct
+)
+
+// hclToken is a token of an HCL document
+type hclToken struct {
+	kind   hclTokenKind
+	text   string
+	line   int
+	column int
+	offset int
+}
+
+// String describes the token for error messages
+func (t hclToken) String() string {
+	switch t.kind {
+	case hclEOF:
+		return "end of file"
+	case hclString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// hclParser reads tokens and values from an HCL document
+type hclParser struct {
+	src       string
+	pos       int
+	line      int
+	lineStart int
+	peeked    *hclToken
+	peekedErr error
+}
+
+// errorf returns an error at the line of tok
+func (p *hclParser) errorf(tok hclToken, format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", tok.line, fmt.Sprintf(format, args...))
+}
+
+// enumBlock parses `"NAME" { attributes }` after the enum keyword, the index-th block
+func (p *hclParser) enumBlock(index int) (EnumDefinition, error) {
+	label, err := p.next()
+	if err != nil {
+		return EnumDefinition{}, err
+	}
+	if label.kind != hclString {
+		return EnumDefinition{}, p.errorf(label, "expected the enum name as a quoted label, found %s", label)
+	}
+	def := EnumDefinition{Name: label.text}
+	if err := p.expect("{"); err != nil {
+		return EnumDefinition{}, err
+	}
+
+	seen := make(map[string]bool)
+	for {
+		tok, err := p.next()
+		if err != nil {
+			return EnumDefinition{}, err
+		}
+		if tok.kind == hclPunct && tok.text == "}" {
+			return def, nil
+		}
+		if tok.kind != hclIdent {
+			return EnumDefinition{}, p.errorf(tok, "expected an attribute of enum %s, found %s", def.Name, tok)
+		}
+		if seen[tok.text] {
+			return EnumDefinition{}, p.errorf(tok, "duplicate attribute %s in enum %s", tok.text, def.Name)
+		}
+		seen[tok.text] = true
+		if err := p.expect("="); err != nil {
+			return EnumDefinition{}, err
+		}
+		if tok.text == "value" && (p.peekPunct("[") || p.peekPunct("{")) {
+			start := *p.peeked
+			return EnumDefinition{}, &DefinitionError{
+				Index: index, Name: def.Name, Offset: int64(start.offset), Line: start.line, Column: start.column,
+				Err: fmt.Errorf("value must be a string, number, boolean or null, found %s", start),
+			}
+		}
+		value, err := p.value()
+		if err != nil {
+			return EnumDefinition{}, err
+		}
+		if err := setHCLAttribute(&def, tok.text, value); err != nil {
+			return EnumDefinition{}, p.errorf(tok, "%v", err)
+		}
+	}
+}
+
+// setHCLAttribute sets the definition field an attribute stands for
+func setHCLAttribute(def *EnumDefinition, name string, value interface{}) error {
+	var ok bool
+	switch name {
+	case "value":
+		def.Value, ok = value, true
+	case "description":
+		def.Description, ok = value.(string)
+	case "group":
+		def.Group, ok = value.(string)
+	case "display_name":
+		def.DisplayName, ok = value.(string)
+	case "aliases":
+		def.Aliases, ok = hclStrings(value)
+	case "tags":
+		def.Tags, ok = hclStrings(value)
+	case "meta":
+		def.Meta, ok = value.(map[string]interface{})
+	default:
+		return fmt.Errorf("unknown attribute %s (allowed: aliases, description, display_name, group, meta, tags, value)", name)
+	}
+	if !ok {
+		return fmt.Errorf("attribute %s has the wrong type", name)
+	}
+	return nil
+}
+
+// hclStrings converts a list of strings
+func hclStrings(value interface{}) ([]string, bool) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	strs := make([]string, len(list))
+	for i, item := range list {
+		if strs[i], ok = item.(string); !ok {
+			return nil, false
+		}
+	}
+	return strs, true
+}
+
+// value parses a literal value: a string, number, boolean, null, list or object
+func (p *hclParser) value() (interface{}, error) {
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch tok.kind {
+	case hclString:
+		return tok.text, nil
+	case hclNumber:
+		i, err := strconv.Atoi(tok.text)
+		if err == nil {
+			return i, nil
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return nil, p.errorf(tok, "integer %s overflows int", tok.text)
+		}
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid number %s", tok.text)
+		}
+		return f, nil
+	case hclIdent:
+		switch tok.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+	case hclPunct:
+		switch tok.text {
+		case "[":
+			return p.list()
+		case "{":
+			return p.object()
+		}
+	}
+	return nil, p.errorf(tok, "expected a value, found %s", tok)
+}
+
+// list parses the items of a list after its opening bracket
+func (p *hclParser) list() ([]interface{}, error) {
+	list := []interface{}{}
+	for {
+		if p.peekPunct("]") {
+			p.next()
+			return list, nil
+		}
+		item, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, item)
+		if p.peekPunct(",") {
+			p.next()
+		} else if !p.peekPunct("]") {
+			tok, _ := p.next()
+			return nil, p.errorf(tok, "expected , or ] in list, found %s", tok)
+		}
+	}
+}
+
+// object parses the attributes of an object after its opening brace
+func (p *hclParser) object() (map[string]interface{}, error) {
+	object := make(map[string]interface{})
+	for {
+		key, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if key.kind == hclPunct && key.text == "}" {
+			return object, nil
+		}
+		if key.kind != hclIdent && key.kind != hclString {
+			return nil, p.errorf(key, "expected an object key, found %s", key)
+		}
+		sep, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if sep.kind != hclPunct || (sep.text != "=" && sep.text != ":") {
+			return nil, p.errorf(sep, "expected = after %s, found %s", key.text, sep)
+		}
+		if object[key.text], err = p.value(); err != nil {
+			return nil, err
+		}
+		if p.peekPunct(",") {
+			p.next()
+		}
+	}
+}
+
+// expect consumes the punctuation text
+func (p *hclParser) expect(text string) error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if tok.kind != hclPunct || tok.text != text {
+		return p.errorf(tok, "expected %q, found %s", text, tok)
+	}
+	return nil
+}
+
+// peekPunct reports whether the next token is the punctuation text
+func (p *hclParser) peekPunct(text string) bool {
+	if p.peeked == nil {
+		tok, err := p.next()
+		if err != nil {
+			// Keep the error for the next read rather than losing it
+			p.peekedErr = err
+			return false
+		}
+		p.peeked = &tok
+	}
+	return p.peeked.kind == hclPunct && p.peeked.text == text
+}
+
+// next returns the next token, skipping whitespace, newlines and comments
+func (p *hclParser) next() (hclToken, error) {
+	if p.peekedErr != nil {
+		return hclToken{}, p.peekedErr
+	}
+	if p.peeked != nil {
+		tok := *p.peeked
+		p.peeked = nil
+		return tok, nil
+	}
+	if err := p.skipSpace(); err != nil {
+		return hclToken{}, err
+	}
+	start := p.pos
+	tok := hclToken{line: p.line, column: start - p.lineStart + 1, offset: start}
+	if p.pos >= len(p.src) {
+		tok.kind = hclEOF
+		return tok, nil
+	}
+
+	switch c := p.src[p.pos]; {
+	case c == '"':
+		return p.str()
+	case c == '-' || c >= '0' && c <= '9':
+		p.pos++
+		for p.pos < len(p.src) && strings.IndexByte("0123456789.eE+-", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		if p.pos < len(p.src) && isHCLIdentByte(p.src[p.pos]) {
+			// Catch 0x10 and the like here rather than as a stray identifier after 0
+			for p.pos < len(p.src) && isHCLIdentByte(p.src[p.pos]) {
+				p.pos++
+			}
+			return hclToken{}, fmt.Errorf("line %d: invalid number %s: only decimal numbers are supported", tok.line, p.src[start:p.pos])
+		}
+		tok.kind, tok.text = hclNumber, p.src[start:p.pos]
+		return tok, nil
+	case strings.IndexByte("{}[]=,:", c) >= 0:
+		p.pos++
+		tok.kind, tok.text = hclPunct, string(c)
+		return tok, nil
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.src) && (p.src[p.pos] == '-' || isHCLIdentByte(p.src[p.pos])) {
+			p.pos++
+		}
+		tok.kind, tok.text = hclIdent, p.src[start:p.pos]
+		return tok, nil
+	}
+	return hclToken{}, fmt.Errorf("line %d: unexpected character %q", p.line, p.src[start])
+}
+
+// isHCLIdentByte reports whether c continues an identifier
+func isHCLIdentByte(c byte) bool {
+	return c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+// str reads a quoted string with its escapes
+func (p *hclParser) str() (hclToken, error) {
+	line, column, offset := p.line, p.pos-p.lineStart+1, p.pos
+	var b strings.Builder
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		switch c := p.src[p.pos]; c {
+		case '"':
+			p.pos++
+			return hclToken{kind: hclString, text: b.String(), line: line, column: column, offset: offset}, nil
+		case '\n':
+			return hclToken{}, fmt.Errorf("line %d: unterminated string", line)
+		case '$', '%':
+			if p.pos+1 < len(p.src) && p.src[p.pos+1] == '{' {
+				return hclToken{}, fmt.Errorf("line %d: templates are not supported", line)
+			}
+			b.WriteByte(c)
+		case '\\':
+			p.pos++
+			if p.pos >= len(p.src) {
+				break
+			}
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '"', '\\':
+				b.WriteByte(e)
+			default:
+				return hclToken{}, fmt.Errorf("line %d: unsupported escape \\%c", line, e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return hclToken{}, fmt.Errorf("line %d: unterminated string", line)
+}
+
+// skipSpace skips whitespace, newlines and comments
+func (p *hclParser) skipSpace() error {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == '\n':
+			p.line++
+			p.pos++
+			p.lineStart = p.pos
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case c == '#' || strings.HasPrefix(p.src[p.pos:], "//"):
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.src[p.pos:], "/*"):
+			end := strings.Index(p.src[p.pos+2:], "*/")
+			if end < 0 {
+				return fmt.Errorf("line %d: unterminated comment", p.line)
+			}
+			comment := p.src[p.pos : p.pos+2+end]
+			if lines := strings.Count(comment, "\n"); lines > 0 {
+				p.line += lines
+				p.lineStart = p.pos + strings.LastIndexByte(comment, '\n') + 1
+			}
+			p.pos += end + 4
+		default:
+			return nil
+		}
+	}
+	return nil
+}
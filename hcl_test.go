@@ -0,0 +1,112 @@
+package goenum
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHCL = `
+# Order statuses
+enum "ACTIVE" {
+  value        = 1
+  description  = "Active \"now\""
+  aliases      = ["on", "enabled"]
+  group        = "live"
+  display_name = "Active"
+  tags         = ["billing"]
+  meta         = { tier = "gold", weight: 1.5 }
+}
+
+/* closed orders
+   are kept */
+enum "CLOSED" {
+  value       = 2 // final
+  description = "Closed"
+}
+`
+
+func TestLoadFromHCL(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "status.hcl")
+	assert.NoError(t, os.WriteFile(filename, []byte(testHCL), 0o644))
+
+	t.Run("LoadFromHCL()", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromHCL(filename))
+
+		defs := loader.GetEnumSet().Definitions()
+		if assert.Len(t, defs, 2) {
+			assert.Equal(t, EnumDefinition{
+				Name:        "ACTIVE",
+				Value:       1,
+				Description: `Active "now"`,
+				Aliases:     []string{"on", "enabled"},
+				Group:       "live",
+				DisplayName: "Active",
+				Tags:        []string{"billing"},
+				Meta:        map[string]interface{}{"tier": "gold", "weight": 1.5},
+			}, defs[0])
+			assert.Equal(t, "CLOSED", defs[1].Name)
+			assert.Equal(t, 2, defs[1].Value)
+		}
+	})
+
+	t.Run("registered format", func(t *testing.T) {
+		RegisterLoaderFormat(".hcl", HCLCodec)
+		t.Cleanup(func() { UnregisterLoaderFormat(".hcl") })
+
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromDirectory(dir))
+		_, exists := loader.GetEnumSet().GetByName("enabled")
+		assert.True(t, exists)
+	})
+
+	t.Run("invalid documents", func(t *testing.T) {
+		for _, tc := range []struct{ doc, err string }{
+			{`enum ACTIVE {}`, `line 1: expected the enum name as a quoted label, found "ACTIVE"`},
+			{"enum \"A\" {\n  colour = 1\n}", "line 2: unknown attribute colour"},
+			{"enum \"A\" {\n  value = 1\n  value = 2\n}", "line 3: duplicate attribute value in enum A"},
+			{`enum "A" { description = 1 }`, "line 1: attribute description has the wrong type"},
+			{`enum "A" { description = "${var.x}" }`, "line 1: templates are not supported"},
+			{"enum \"A\" {\n  value = 1", "line 2: expected an attribute of enum A, found end of file"},
+			{`variable "x" {}`, `line 1: expected an enum block, found "variable"`},
+			{`enum "A" { value = 0x10 }`, "line 1: invalid number 0x10: only decimal numbers are supported"},
+			{`enum "A" { value = 99999999999999999999 }`, "line 1: integer 99999999999999999999 overflows int"},
+			{`enum "A" { value = 1.5e3x }`, "line 1: invalid number 1.5e3x"},
+		} {
+			_, err := HCLCodec.Decode(strings.NewReader(tc.doc))
+			if assert.Error(t, err, tc.doc) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		}
+	})
+
+	t.Run("non-scalar values", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bad.hcl")
+		doc := "enum \"A\" { value = 1 }\n/* lists\n   and objects */ enum \"B\" {\n  value = [1, 2]\n}\nenum \"C\" { value = { a = 1 } }"
+		assert.NoError(t, os.WriteFile(path, []byte(doc), 0644))
+
+		err := NewDynamicEnumLoader(nil).LoadFromHCL(path)
+		var definitionErr *DefinitionError
+		if assert.ErrorAs(t, err, &definitionErr) {
+			assert.Equal(t, path, definitionErr.File)
+			assert.Equal(t, 1, definitionErr.Index)
+			assert.Equal(t, "B", definitionErr.Name)
+			assert.Equal(t, 4, definitionErr.Line)
+			assert.Equal(t, 11, definitionErr.Column)
+			assert.Equal(t, int64(strings.Index(doc, "[")), definitionErr.Offset)
+			assert.ErrorContains(t, err, `value must be a string, number, boolean or null, found "["`)
+		}
+
+		_, err = HCLCodec.Decode(strings.NewReader(`enum "C" { value = { a = 1 } }`))
+		if assert.ErrorAs(t, err, &definitionErr) {
+			assert.Equal(t, 0, definitionErr.Index)
+			assert.Equal(t, 1, definitionErr.Line)
+			assert.Equal(t, 20, definitionErr.Column)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package goenum
+
+import "fmt"
+
+// MarshalFunc encodes an enum in a custom wire format
+type MarshalFunc func(enum Enum) ([]byte, error)
+
+// UnmarshalFunc decodes a custom wire format into the definition it describes.
+// Registered enums are resolved by the definition's name, or by its value when the name is empty.
+type UnmarshalFunc func(data []byte) (EnumDefinition, error)
+
+// unmarshalHook decodes data with hook and stores the resulting definition in the enum
+func (e *EnumBase) unmarshalHook(data []byte, hook UnmarshalFunc) error {
+	def, err := hook(data)
+	if err != nil {
+		return err
+	}
+	// Convert float64 to int if necessary
+	if f, ok := def.Value.(float64); ok {
+		def.Value = int(f)
+	}
+	e.name = def.Name
+	e.value = def.Value
+	e.description = def.Description
+	e.aliases = def.Aliases
+	e.group = def.Group
+	return nil
+}
+
+// parseJSONHook decodes data with hook and resolves the registered enum it refers to
+func (es *EnumSet[T]) parseJSONHook(data []byte, config *EnumJSONConfig) (T, error) {
+	var zero T
+	decoded := &EnumBase{}
+	if err := decoded.unmarshalHook(data, config.UnmarshalFunc); err != nil {
+		return zero, err
+	}
+	if decoded.name != "" {
+		return es.parseJSONName(decoded.name, config)
+	}
+	enum, exists := es.resolveValue(decoded.value)
+	if !exists {
+		return zero, newUnknownEnumError(fmt.Sprint(decoded.value), es)
+	}
+	return enum, nil
+}
@@ -0,0 +1,103 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// legacyHooks encode enums as {"code": 3, "label": "..."}
+func legacyHooks() *EnumJSONConfig {
+	type legacy struct {
+		Code  int    `json:"code"`
+		Label string `json:"label"`
+	}
+	return &EnumJSONConfig{
+		MarshalFunc: func(enum Enum) ([]byte, error) {
+			return json.Marshal(legacy{Code: enum.Value().(int), Label: enum.Description()})
+		},
+		UnmarshalFunc: func(data []byte) (EnumDefinition, error) {
+			var l legacy
+			if err := json.Unmarshal(data, &l); err != nil {
+				return EnumDefinition{}, err
+			}
+			return EnumDefinition{Value: l.Code, Description: l.Label}, nil
+		},
+	}
+}
+
+func TestJSONHooks(t *testing.T) {
+	t.Run("set-level marshal hook", func(t *testing.T) {
+		a := NewEnumBase(1, "A", "First")
+		NewEnumSet[*EnumBase]().Register(a).SetJSONConfig(legacyHooks())
+
+		data, err := json.Marshal(a)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code":1,"label":"First"}`, string(data), "MarshalFunc should replace the format")
+	})
+
+	t.Run("per-enum marshal hook", func(t *testing.T) {
+		a := NewEnumBase(1, "A", "First")
+		a.SetJSONConfig(legacyHooks())
+
+		data, err := a.MarshalJSON()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code":1,"label":"First"}`, string(data))
+	})
+
+	t.Run("unmarshal hook", func(t *testing.T) {
+		decoded := &EnumBase{}
+		assert.NoError(t, decoded.UnmarshalJSONWithConfig([]byte(`{"code":2,"label":"Second"}`), legacyHooks()))
+		assert.Equal(t, 2, decoded.Value(), "value should come from the hook")
+		assert.Equal(t, "Second", decoded.Description(), "description should come from the hook")
+	})
+
+	t.Run("strict unmarshal hook resolves by value", func(t *testing.T) {
+		a := NewEnumBase(1, "A", "First")
+		NewEnumSet[*EnumBase]().Register(a)
+		config := legacyHooks()
+		config.Strict = true
+		a.SetJSONConfig(config)
+
+		decoded := a.Clone()
+		assert.NoError(t, decoded.UnmarshalJSON([]byte(`{"code":1,"label":"ignored"}`)))
+		assert.Equal(t, "A", decoded.String(), "name should be restored from the set")
+		assert.Equal(t, "First", decoded.Description(), "description should be restored from the set")
+
+		err := decoded.UnmarshalJSON([]byte(`{"code":9}`))
+		assert.ErrorIs(t, err, ErrUnknownEnum)
+	})
+
+	t.Run("ParseJSON with hook", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).
+			SetJSONConfig(legacyHooks())
+
+		enum, err := set.ParseJSON([]byte(`{"code":2}`))
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumB, enum, "ParseJSON() should resolve the hook's value")
+
+		_, err = set.ParseJSON([]byte(`{"code":7}`))
+		assert.EqualError(t, err, `unknown enum "7" (allowed: A, B)`)
+
+		_, err = set.ParseJSON([]byte(`"A"`))
+		assert.Error(t, err, "hook errors should be returned")
+	})
+
+	t.Run("ParseJSON with name from hook", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).
+			SetJSONConfig(&EnumJSONConfig{
+				UnmarshalFunc: func(data []byte) (EnumDefinition, error) {
+					var wrapped struct {
+						Kind string `json:"kind"`
+					}
+					err := json.Unmarshal(data, &wrapped)
+					return EnumDefinition{Name: wrapped.Kind}, err
+				},
+			})
+
+		enum, err := set.ParseJSON([]byte(`{"kind":"alpha"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumA, enum, "ParseJSON() should resolve names and aliases")
+	})
+}
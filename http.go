@@ -0,0 +1,122 @@
+package goenum
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CatalogHandler serves an EnumSet as a JSON catalog over HTTP.
+// GET / lists all enums (optionally filtered with ?group=), GET /{name} returns a single enum.
+type CatalogHandler[T Enum] struct {
+	set *EnumSet[T]
+	// Localize, if set, returns the description of an enum in the given language,
+	// or an empty string if no translation exists
+	Localize func(enum T, language string) string
+}
+
+// Handler returns a CatalogHandler serving the set; mount it with http.StripPrefix
+func (es *EnumSet[T]) Handler() *CatalogHandler[T] {
+	return &CatalogHandler[T]{set: es}
+}
+
+// ServeHTTP implements http.Handler
+func (h *CatalogHandler[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	languages := parseAcceptLanguage(r.Header.Get("Accept-Language"))
+	name := strings.Trim(r.URL.Path, "/")
+	if name != "" {
+		enum, exists := h.set.GetByName(name)
+		if !exists {
+			http.Error(w, "enum not found: "+name, http.StatusNotFound)
+			return
+		}
+		def, language := h.localized(enum, languages)
+		writeCatalogJSON(w, language, def)
+		return
+	}
+
+	group := r.URL.Query().Get("group")
+	definitions := make([]EnumDefinition, 0)
+	language := ""
+	for _, enum := range h.set.Values() {
+		if group != "" && groupOf(enum) != group {
+			continue
+		}
+		def, lang := h.localized(enum, languages)
+		if lang != "" {
+			language = lang
+		}
+		definitions = append(definitions, def)
+	}
+	writeCatalogJSON(w, language, definitions)
+}
+
+// localized returns the enum definition with its description translated to the
+// first accepted language that has a translation, and that language
+func (h *CatalogHandler[T]) localized(enum T, languages []string) (EnumDefinition, string) {
+	def := definitionOf(enum)
+	if h.Localize == nil {
+		return def, ""
+	}
+	for _, language := range languages {
+		if description := h.Localize(enum, language); description != "" {
+			def.Description = description
+			return def, language
+		}
+	}
+	return def, ""
+}
+
+// writeCatalogJSON writes v as a JSON response
+func writeCatalogJSON(w http.ResponseWriter, language string, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if language != "" {
+		w.Header().Set("Content-Language", language)
+	}
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language header ordered by preference
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	tags := make([]weighted, 0)
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			tags = append(tags, weighted{tag: tag, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+
+	languages := make([]string, len(tags))
+	for i, t := range tags {
+		languages[i] = t.tag
+	}
+	return languages
+}
@@ -0,0 +1,101 @@
+package goenum
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogHandler(t *testing.T) {
+	var (
+		invoice = TestEnum{NewEnumBase(1, "INVOICE", "Invoice created").WithGroup("billing")}
+		refund  = TestEnum{NewEnumBase(2, "REFUND", "Refund issued", "CHARGEBACK").WithGroup("billing")}
+		login   = TestEnum{NewEnumBase(3, "LOGIN", "User logged in").WithGroup("auth")}
+	)
+	set := NewEnumSet[TestEnum]().Register(invoice).Register(refund).Register(login)
+
+	serve := func(h http.Handler, method, target string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("list catalog", func(t *testing.T) {
+		rec := serve(set.Handler(), http.MethodGet, "/", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		var defs []EnumDefinition
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &defs))
+		assert.Len(t, defs, 3, "handler should list every enum")
+		assert.Equal(t, "INVOICE", defs[0].Name, "handler should list enums sorted by name")
+	})
+
+	t.Run("filter by group", func(t *testing.T) {
+		rec := serve(set.Handler(), http.MethodGet, "/?group=billing", nil)
+		var defs []EnumDefinition
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &defs))
+		assert.Len(t, defs, 2, "handler should only list enums in the group")
+	})
+
+	t.Run("detail by name and alias", func(t *testing.T) {
+		rec := serve(set.Handler(), http.MethodGet, "/REFUND", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var def EnumDefinition
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &def))
+		assert.Equal(t, "REFUND", def.Name)
+		assert.Equal(t, "billing", def.Group)
+
+		rec = serve(set.Handler(), http.MethodGet, "/chargeback", nil)
+		assert.Equal(t, http.StatusOK, rec.Code, "handler should resolve aliases")
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		rec := serve(set.Handler(), http.MethodGet, "/MISSING", nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		rec := serve(set.Handler(), http.MethodPost, "/", nil)
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("localization", func(t *testing.T) {
+		h := set.Handler()
+		h.Localize = func(e TestEnum, language string) string {
+			if language == "fr" && e.String() == "LOGIN" {
+				return "Utilisateur connecté"
+			}
+			return ""
+		}
+		rec := serve(h, http.MethodGet, "/LOGIN", map[string]string{"Accept-Language": "de;q=0.5, fr;q=0.9, en;q=0.1"})
+		var def EnumDefinition
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &def))
+		assert.Equal(t, "Utilisateur connecté", def.Description, "handler should use the preferred translated language")
+		assert.Equal(t, "fr", rec.Header().Get("Content-Language"))
+
+		rec = serve(h, http.MethodGet, "/INVOICE", map[string]string{"Accept-Language": "fr"})
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &def))
+		assert.Equal(t, "Invoice created", def.Description, "handler should fall back to the default description")
+	})
+
+	t.Run("mounted under prefix", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle("/enums/status/", http.StripPrefix("/enums/status", set.Handler()))
+		rec := serve(mux, http.MethodGet, "/enums/status/LOGIN", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, []string{"fr-CH", "fr", "en"}, parseAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5"))
+	assert.Equal(t, []string{"en"}, parseAcceptLanguage("de;q=0, en"))
+	assert.Empty(t, parseAcceptLanguage(""))
+}
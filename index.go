@@ -0,0 +1,82 @@
+package goenum
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// enumIndex is a secondary lookup table keyed by a user-defined function
+type enumIndex[T Enum] struct {
+	key     func(T) interface{}
+	entries map[interface{}]T
+}
+
+// check returns an error if the enum's key cannot be a map key or is already taken in
+// the index
+func (idx *enumIndex[T]) check(name string, enum T) error {
+	key := idx.key(enum)
+	if key == nil {
+		return nil
+	}
+	if !reflect.ValueOf(key).Comparable() {
+		return fmt.Errorf("key of type %T in enum index %s is not comparable", key, name)
+	}
+	if _, exists := idx.entries[key]; exists {
+		return fmt.Errorf("duplicate key %v in enum index %s", key, name)
+	}
+	return nil
+}
+
+// add stores the enum under its key, skipping enums without a key
+func (idx *enumIndex[T]) add(enum T) {
+	if key := idx.key(enum); key != nil {
+		idx.entries[key] = enum
+	}
+}
+
+// remove deletes the enum's key from the index
+func (idx *enumIndex[T]) remove(enum T) {
+	if key := idx.key(enum); key != nil {
+		delete(idx.entries, key)
+	}
+}
+
+// AddIndex adds a secondary index computed by key and returns the EnumSet for chaining.
+// Enums for which key returns nil are left out of the index; keys must be comparable.
+// It panics on duplicate index names or keys and on keys that are not comparable, such
+// as slices and maps, unless in panic-free mode.
+func (es *EnumSet[T]) AddIndex(name string, key func(T) interface{}) *EnumSet[T] {
+	if _, exists := es.indexes[name]; exists {
+		es.fail(fmt.Errorf("duplicate enum index: %s", name))
+		return es
+	}
+
+	index := &enumIndex[T]{
+		key:     key,
+		entries: make(map[interface{}]T),
+	}
+	for _, enum := range es.Values() {
+		if err := index.check(name, enum); err != nil {
+			es.fail(err)
+			return es
+		}
+		index.add(enum)
+	}
+
+	if es.indexes == nil {
+		es.indexes = make(map[string]*enumIndex[T])
+	}
+	es.indexes[name] = index
+	return es
+}
+
+// GetByIndex retrieves an enum by its key in the named secondary index
+func (es *EnumSet[T]) GetByIndex(name string, key interface{}) (T, bool) {
+	index, exists := es.indexes[name]
+	if !exists || key != nil && !reflect.ValueOf(key).Comparable() {
+		var zero T
+		return zero, false
+	}
+	enum, exists := index.entries[key]
+	return enum, exists
+}
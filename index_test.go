@@ -0,0 +1,92 @@
+package goenum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetIndexes(t *testing.T) {
+	newIndexedSet := func() *EnumSet[TestEnum] {
+		return NewEnumSet[TestEnum]().
+			Register(TestEnumA).
+			Register(TestEnumB).
+			AddIndex("lower", func(e TestEnum) interface{} {
+				return strings.ToLower(e.String())
+			})
+	}
+
+	t.Run("lookup existing enums", func(t *testing.T) {
+		set := newIndexedSet()
+		enum, exists := set.GetByIndex("lower", "a")
+		assert.True(t, exists, "GetByIndex() should find enum registered before the index was added")
+		assert.Equal(t, TestEnumA, enum, "GetByIndex() should return correct enum")
+
+		_, exists = set.GetByIndex("lower", "z")
+		assert.False(t, exists, "GetByIndex() should return false for unknown key")
+	})
+
+	t.Run("lookup enums registered after index", func(t *testing.T) {
+		set := newIndexedSet()
+		set.Register(TestEnumC)
+		enum, exists := set.GetByIndex("lower", "c")
+		assert.True(t, exists, "GetByIndex() should find enum registered after the index was added")
+		assert.Equal(t, TestEnumC, enum, "GetByIndex() should return correct enum")
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		_, exists := newIndexedSet().GetByIndex("missing", "a")
+		assert.False(t, exists, "GetByIndex() should return false for unknown index")
+	})
+
+	t.Run("nil keys are skipped", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().
+			Register(TestEnumA).
+			Register(TestEnumB).
+			AddIndex("alias", func(e TestEnum) interface{} {
+				if len(e.Aliases()) > 1 {
+					return e.Aliases()[1]
+				}
+				return nil
+			})
+		set.Register(TestEnumC)
+		enum, exists := set.GetByIndex("alias", "THIRD")
+		assert.True(t, exists, "GetByIndex() should find enum with key")
+		assert.Equal(t, TestEnumC, enum, "GetByIndex() should return correct enum")
+		_, exists = set.GetByIndex("alias", nil)
+		assert.False(t, exists, "GetByIndex() should not index nil keys")
+	})
+
+	t.Run("duplicate index name", func(t *testing.T) {
+		set := newIndexedSet()
+		assert.Panics(t, func() {
+			set.AddIndex("lower", func(e TestEnum) interface{} { return e.Value() })
+		}, "AddIndex() should panic on duplicate index name")
+	})
+
+	t.Run("duplicate index key", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().
+			Register(TestEnumA).
+			AddIndex("constant", func(TestEnum) interface{} { return 1 })
+		assert.Panics(t, func() {
+			set.Register(TestEnumB)
+		}, "Register() should panic on duplicate index key")
+		assert.False(t, set.Contains(TestEnumB), "Register() should not add enum when index key is duplicate")
+	})
+
+	t.Run("non-comparable index key", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA)
+		assert.Panics(t, func() {
+			set.AddIndex("slice", func(e TestEnum) interface{} { return []string{e.String()} })
+		}, "AddIndex() should panic on non-comparable keys of existing enums")
+
+		set = NewEnumSet[TestEnum]().
+			AddIndex("slice", func(e TestEnum) interface{} { return []string{e.String()} })
+		err := set.TryRegister(TestEnumA)
+		assert.ErrorContains(t, err, "not comparable", "TryRegister() should reject non-comparable index keys")
+		assert.False(t, set.Contains(TestEnumA), "TryRegister() should not add enum with non-comparable index key")
+		_, exists := set.GetByIndex("slice", []string{"A"})
+		assert.False(t, exists, "GetByIndex() should not panic on non-comparable keys")
+	})
+}
@@ -0,0 +1,70 @@
+package goenum
+
+// enumArenaSize is the number of enums allocated at once when interning
+const enumArenaSize = 256
+
+// newEnum creates the enum for a loaded definition, interning its strings and
+// allocating it from a block of enums when the options ask for it
+func (l *DynamicEnumLoader) newEnum(def EnumDefinition) *EnumBase {
+	if !l.options.Intern {
+		return &EnumBase{
+			name:        def.Name,
+			value:       def.Value,
+			description: def.Description,
+			aliases:     def.Aliases,
+			group:       def.Group,
+			displayName: def.DisplayName,
+			meta:        def.Meta,
+			tags:        def.Tags,
+		}
+	}
+
+	var aliases []string
+	if len(def.Aliases) > 0 {
+		aliases = make([]string, len(def.Aliases))
+		for i, alias := range def.Aliases {
+			aliases[i] = l.intern(alias)
+		}
+	}
+
+	var tags []string
+	if len(def.Tags) > 0 {
+		tags = make([]string, len(def.Tags))
+		for i, tag := range def.Tags {
+			tags[i] = l.intern(tag)
+		}
+	}
+
+	if len(l.arena) == 0 {
+		l.arena = make([]EnumBase, enumArenaSize)
+	}
+	enum := &l.arena[0]
+	l.arena = l.arena[1:]
+	*enum = EnumBase{
+		name:        def.Name,
+		value:       def.Value,
+		description: l.intern(def.Description),
+		aliases:     aliases,
+		group:       l.intern(def.Group),
+		displayName: def.DisplayName,
+		meta:        def.Meta,
+		tags:        tags,
+	}
+	return enum
+}
+
+// intern returns the first string equal to s seen by the loader. The table is never
+// pruned, so it holds every distinct string loaded for as long as the loader lives.
+func (l *DynamicEnumLoader) intern(s string) string {
+	if s == "" {
+		return ""
+	}
+	if interned, exists := l.interned[s]; exists {
+		return interned
+	}
+	if l.interned == nil {
+		l.interned = make(map[string]string)
+	}
+	l.interned[s] = s
+	return s
+}
@@ -0,0 +1,71 @@
+package goenum
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// catalogJSON returns a JSON array of n definitions sharing a few descriptions and groups
+func catalogJSON(n int) []byte {
+	var b bytes.Buffer
+	b.WriteString("[")
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"name": "CODE_%d", "value": %d, "description": "Shared description of category %d", "group": "group-%d"}`,
+			i, i, i%10, i%5)
+	}
+	b.WriteString("]")
+	return b.Bytes()
+}
+
+func TestInterning(t *testing.T) {
+	loader := NewDynamicEnumLoader(&ValidationOptions{Intern: true})
+	assert.NoError(t, loader.LoadFromReader(bytes.NewReader(catalogJSON(600))))
+	assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{
+		{Name: "EXTRA", Value: 600, Description: "Shared description of category 1", Aliases: []string{"LEGACY"}},
+	}))
+	set := loader.GetEnumSet()
+	assert.Len(t, set.Values(), 601)
+
+	a, _ := set.GetByName("CODE_1")
+	b, _ := set.GetByName("CODE_11")
+	extra, _ := set.GetByName("EXTRA")
+	assert.Equal(t, "Shared description of category 1", a.Description())
+	assert.Equal(t, unsafe.StringData(a.Description()), unsafe.StringData(b.Description()), "equal descriptions should share memory")
+	assert.Equal(t, unsafe.StringData(a.Description()), unsafe.StringData(extra.Description()), "interning should span loads")
+	assert.Equal(t, []string{"LEGACY"}, extra.Aliases())
+	assert.Equal(t, "group-1", groupOf(a))
+}
+
+func BenchmarkLoadInterning(b *testing.B) {
+	data := catalogJSON(100000)
+	for _, intern := range []bool{false, true} {
+		b.Run(fmt.Sprintf("Intern=%v", intern), func(b *testing.B) {
+			b.ReportAllocs()
+			var heap int64
+			for i := 0; i < b.N; i++ {
+				var before, after runtime.MemStats
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+
+				loader := NewDynamicEnumLoader(&ValidationOptions{Intern: intern})
+				if err := loader.LoadFromReader(bytes.NewReader(data)); err != nil {
+					b.Fatal(err)
+				}
+
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+				heap += int64(after.HeapAlloc) - int64(before.HeapAlloc)
+				runtime.KeepAlive(loader)
+			}
+			b.ReportMetric(float64(heap)/float64(b.N), "retained-B/op")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package interop
+
+import (
+	"testing"
+
+	"github.com/abdorrahmani/goenum/goenumbson"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+)
+
+// bsonCodec is the codec instantiated with the driver's types
+type bsonCodec = goenumbson.Codec[bsoncodec.EncodeContext, bsonrw.ValueWriter, bsoncodec.DecodeContext, bsonrw.ValueReader]
+
+// The codec must be a driver encoder and decoder, and the driver's readers and writers
+// must provide what the codec uses
+var (
+	_ bsoncodec.ValueEncoder = (*bsonCodec)(nil)
+	_ bsoncodec.ValueDecoder = (*bsonCodec)(nil)
+	_ goenumbson.ValueWriter = bsonrw.ValueWriter(nil)
+	_ goenumbson.ValueReader = bsonrw.ValueReader(nil)
+)
+
+type document struct {
+	Status Status `bson:"status"`
+}
+
+func TestBSONCodecs(t *testing.T) {
+	rb := bson.NewRegistryBuilder()
+	err := goenumbson.RegisterCodecs[bsoncodec.EncodeContext, bsonrw.ValueWriter,
+		bsoncodec.DecodeContext, bsonrw.ValueReader](rb, newStatusSet())
+	assert.NoError(t, err)
+	registry := rb.Build()
+
+	data, err := bson.MarshalWithRegistry(registry, document{Status: StatusClosed})
+	assert.NoError(t, err)
+	assert.Equal(t, "CLOSED", bson.Raw(data).Lookup("status").StringValue(), "enums should be stored by name")
+
+	var decoded document
+	assert.NoError(t, bson.UnmarshalWithRegistry(registry, data, &decoded))
+	assert.Equal(t, StatusClosed, decoded.Status, "names should decode to the registered enum")
+
+	data, err = bson.Marshal(bson.M{"status": "DELETED"})
+	assert.NoError(t, err)
+	assert.Error(t, bson.UnmarshalWithRegistry(registry, data, &decoded), "unknown names should fail")
+}
@@ -0,0 +1,8 @@
+// Package interop checks the integration subpackages against the libraries they adapt.
+//
+// The subpackages do not import GORM, pgx or the MongoDB driver; they are instantiated
+// with the libraries' types by applications. This module does import them, so that a
+// change on either side that breaks an integration fails to compile here. It is a separate
+// module to keep those dependencies out of goenum's own go.mod; run its tests from this
+// directory with go test ./...
+package interop
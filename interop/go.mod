@@ -0,0 +1,26 @@
+module github.com/abdorrahmani/goenum/interop
+
+go 1.23.4
+
+replace github.com/abdorrahmani/goenum => ../
+
+require (
+	github.com/abdorrahmani/goenum v0.0.0-00010101000000-000000000000
+	github.com/jackc/pgx/v5 v5.7.1
+	github.com/stretchr/testify v1.10.0
+	go.mongodb.org/mongo-driver v1.17.1
+	gorm.io/gorm v1.25.12
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/crypto v0.27.0 // indirect
+	golang.org/x/text v0.18.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
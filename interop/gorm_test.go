@@ -0,0 +1,57 @@
+package interop
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/abdorrahmani/goenum"
+	"github.com/abdorrahmani/goenum/goenumgorm"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+)
+
+// The serializers must satisfy GORM's interface when instantiated with *schema.Field
+var _ schema.SerializerInterface = goenumgorm.NewSerializer[*schema.Field](goenumgorm.StoreName)
+
+type Status struct{ *goenum.EnumBase }
+
+var (
+	StatusActive = Status{goenum.NewEnumBase(1, "ACTIVE", "Active")}
+	StatusClosed = Status{goenum.NewEnumBase(2, "CLOSED", "Closed")}
+)
+
+func newStatusSet() *goenum.EnumSet[Status] {
+	return goenum.NewEnumSet[Status]().Register(StatusActive).Register(StatusClosed)
+}
+
+type order struct {
+	ID     uint
+	Status Status `gorm:"serializer:goenum_value"`
+}
+
+func TestGORMSerializer(t *testing.T) {
+	goenumgorm.Register[*schema.Field](schema.RegisterSerializer, newStatusSet())
+	for _, name := range []string{goenumgorm.SerializerName, goenumgorm.SerializerValue} {
+		_, exists := schema.GetSerializer(name)
+		assert.True(t, exists, "Register() should register %s with GORM", name)
+	}
+
+	parsed, err := schema.Parse(&order{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	field := parsed.LookUpField("Status")
+	if !assert.NotNil(t, field) || !assert.NotNil(t, field.Serializer, "the tag should select the serializer") {
+		return
+	}
+	serializer := goenumgorm.NewSerializer[*schema.Field](goenumgorm.StoreValue, newStatusSet())
+
+	var row order
+	dst := reflect.ValueOf(&row)
+	assert.NoError(t, serializer.Scan(context.Background(), field, dst, int64(2)))
+	assert.Equal(t, StatusClosed, row.Status, "Scan() should set the field through schema.Field")
+
+	value, err := serializer.Value(context.Background(), field, dst, row.Status)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), value)
+}
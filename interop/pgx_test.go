@@ -0,0 +1,58 @@
+package interop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abdorrahmani/goenum/goenumpgx"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+// The codec and the connection interfaces must match pgx when instantiated with its types
+var (
+	_ pgtype.Codec                              = goenumpgx.NewCodec[*pgtype.Map, pgtype.EncodePlan, pgtype.ScanPlan, Status](nil)
+	_ goenumpgx.Conn[*pgtype.Type, *pgtype.Map] = (*pgx.Conn)(nil)
+	_ goenumpgx.TypeMap[*pgtype.Type]           = (*pgtype.Map)(nil)
+)
+
+// statusOID is the OID given to the status type; real ones come from LoadType
+const statusOID = 90001
+
+// typeConn loads every type as an enum type, like pgx does for CREATE TYPE ... AS ENUM
+type typeConn struct {
+	typeMap *pgtype.Map
+}
+
+func (c *typeConn) LoadType(ctx context.Context, typeName string) (*pgtype.Type, error) {
+	return &pgtype.Type{Name: typeName, OID: statusOID, Codec: &pgtype.EnumCodec{}}, nil
+}
+
+func (c *typeConn) TypeMap() *pgtype.Map {
+	return c.typeMap
+}
+
+func TestPgxCodec(t *testing.T) {
+	conn := &typeConn{typeMap: pgtype.NewMap()}
+	err := goenumpgx.RegisterSet[*pgtype.Type, *pgtype.Map, pgtype.EncodePlan, pgtype.ScanPlan](
+		context.Background(), conn, "status", newStatusSet())
+	assert.NoError(t, err)
+	registered, exists := conn.typeMap.TypeForOID(statusOID)
+	if !assert.True(t, exists) {
+		return
+	}
+	assert.IsType(t, &goenumpgx.Codec[*pgtype.Map, pgtype.EncodePlan, pgtype.ScanPlan, Status]{}, registered.Codec,
+		"RegisterSet() should replace the codec of the loaded type")
+
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		data, err := conn.typeMap.Encode(statusOID, format, StatusClosed, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "CLOSED", string(data), "enums should encode as their labels")
+
+		var status Status
+		assert.NoError(t, conn.typeMap.Scan(statusOID, format, []byte("ACTIVE"), &status))
+		assert.Equal(t, StatusActive, status, "labels should scan into enums")
+		assert.Error(t, conn.typeMap.Scan(statusOID, format, []byte("DELETED"), &status))
+	}
+}
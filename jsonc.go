@@ -0,0 +1,153 @@
+package goenum
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+)
+
+// jsoncReader turns JSONC (JSON with // and /* */ comments and trailing commas) into
+// plain JSON while streaming. Comments and trailing commas are replaced with spaces,
+// keeping newlines, so decoder offsets and line numbers still match the source.
+type jsoncReader struct {
+	src      *bufio.Reader
+	out      []byte
+	pending  []byte // a comma and what follows it, held until the next token shows whether it trails
+	inString bool
+	escaped  bool
+	err      error
+}
+
+// newJSONCReader returns a reader that strips JSONC syntax from reader
+func newJSONCReader(reader io.Reader) io.Reader {
+	return &jsoncReader{src: bufio.NewReader(reader)}
+}
+
+// stripJSONC converts JSONC data to plain JSON of the same length
+func stripJSONC(data []byte) ([]byte, error) {
+	return io.ReadAll(newJSONCReader(bytes.NewReader(data)))
+}
+
+// Read implements io.Reader
+func (r *jsoncReader) Read(p []byte) (int, error) {
+	for len(r.out) < len(p) && r.err == nil {
+		if r.err = r.step(); r.err != nil {
+			r.flush()
+		}
+	}
+	if len(r.out) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// step consumes one byte, or a whole comment, from the source
+func (r *jsoncReader) step() error {
+	b, err := r.src.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if r.inString {
+		r.out = append(r.out, b)
+		switch {
+		case r.escaped:
+			r.escaped = false
+		case b == '\\':
+			r.escaped = true
+		case b == '"':
+			r.inString = false
+		}
+		return nil
+	}
+
+	switch b {
+	case ' ', '\t', '\n', '\r':
+		r.write(b)
+	case '/':
+		next, err := r.src.ReadByte()
+		switch {
+		case err == nil && next == '/':
+			return r.lineComment()
+		case err == nil && next == '*':
+			return r.blockComment()
+		case err == nil:
+			_ = r.src.UnreadByte()
+		}
+		r.flush()
+		r.out = append(r.out, b)
+	case ',':
+		r.flush()
+		r.pending = append(r.pending, b)
+	case ']', '}':
+		if len(r.pending) > 0 {
+			r.pending[0] = ' '
+		}
+		r.flush()
+		r.out = append(r.out, b)
+	default:
+		r.flush()
+		r.out = append(r.out, b)
+		r.inString = b == '"'
+	}
+	return nil
+}
+
+// lineComment blanks a // comment up to the end of the line
+func (r *jsoncReader) lineComment() error {
+	r.write(' ', ' ')
+	for {
+		b, err := r.src.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b == '\n' {
+			r.write(b)
+			return nil
+		}
+		r.write(' ')
+	}
+}
+
+// blockComment blanks a /* */ comment, keeping its newlines
+func (r *jsoncReader) blockComment() error {
+	r.write(' ', ' ')
+	star := false
+	for {
+		b, err := r.src.ReadByte()
+		if err == io.EOF {
+			return errors.New("unterminated block comment")
+		}
+		if err != nil {
+			return err
+		}
+		if star && b == '/' {
+			r.write(' ')
+			return nil
+		}
+		star = b == '*'
+		if b == '\n' || b == '\r' {
+			r.write(b)
+		} else {
+			r.write(' ')
+		}
+	}
+}
+
+// write emits insignificant bytes after any pending comma
+func (r *jsoncReader) write(b ...byte) {
+	if len(r.pending) > 0 {
+		r.pending = append(r.pending, b...)
+		return
+	}
+	r.out = append(r.out, b...)
+}
+
+// flush emits the pending comma unchanged
+func (r *jsoncReader) flush() {
+	r.out = append(r.out, r.pending...)
+	r.pending = r.pending[:0]
+}
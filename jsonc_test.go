@@ -0,0 +1,88 @@
+package goenum
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripJSONC(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain JSON", `[{"a": 1}, {"b": [2, 3]}]`, `[{"a": 1}, {"b": [2, 3]}]`},
+		{"line comment", "[1, // one\n2]", "[1,       \n2]"},
+		{"block comment", "[1 /* a\nb */, 2]", "[1     \n    , 2]"},
+		{"trailing commas", `[{"a": 1,}, 2, ]`, `[{"a": 1 }, 2  ]`},
+		{"trailing comma before comment", "[1, // last\n]", "[1         \n]"},
+		{"comment markers in strings", `["// no", "/* no */", "a,]", "\"//"]`, `["// no", "/* no */", "a,]", "\"//"]`},
+		{"division-like slash", `[1/2]`, `[1/2]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stripJSONC([]byte(tt.input))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+			assert.Len(t, got, len(tt.input), "offsets should be preserved")
+		})
+	}
+
+	t.Run("unterminated block comment", func(t *testing.T) {
+		_, err := stripJSONC([]byte(`[1 /* open`))
+		assert.ErrorContains(t, err, "unterminated block comment")
+	})
+
+	t.Run("one byte reads", func(t *testing.T) {
+		input := "[\n  // comment\n  {\"name\": \"A\", \"value\": 1,},\n]"
+		want, err := stripJSONC([]byte(input))
+		assert.NoError(t, err)
+		assert.NoError(t, iotest.TestReader(newJSONCReader(strings.NewReader(input)), want))
+	})
+}
+
+func TestLoaderJSONC(t *testing.T) {
+	const document = `[
+  // Active orders
+  {"name": "ACTIVE", "value": 1, "aliases": ["RUNNING",],},
+  /* retired:
+     {"name": "LEGACY", "value": 9}, */
+  {"name": "PENDING", "value": 0},
+]`
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip, JSONC: true}
+
+	t.Run("LoadFromReader", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		assert.NoError(t, loader.LoadFromReader(strings.NewReader(document)))
+		assert.ElementsMatch(t, []string{"ACTIVE", "PENDING"}, loader.GetEnumSet().Names())
+		_, exists := loader.GetEnumSet().GetByName("RUNNING")
+		assert.True(t, exists)
+	})
+
+	t.Run("LoadFromStream", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		assert.NoError(t, loader.LoadFromStream(strings.NewReader(document)))
+		assert.ElementsMatch(t, []string{"ACTIVE", "PENDING"}, loader.GetEnumSet().Names())
+	})
+
+	t.Run("LoadFromJSONValidated keeps positions", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "enums.jsonc")
+		assert.NoError(t, os.WriteFile(file, []byte("[\n  // comment\n  {\"name\": \"A\", \"value\": 1, \"label\": \"x\"},\n]"), 0644))
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromJSONValidated(file)
+		var schemaErr *SchemaError
+		assert.True(t, errors.As(err, &schemaErr))
+		assert.Equal(t, 3, schemaErr.Line)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip})
+		assert.Error(t, loader.LoadFromReader(strings.NewReader(document)))
+	})
+}
@@ -0,0 +1,46 @@
+package goenum
+
+// enumOwner is implemented by sets that share configuration with their enums
+type enumOwner interface {
+	AnyEnumSet
+	ownerJSONConfig() *EnumJSONConfig
+	ownerUnknown() (Enum, bool)
+	ownerMigrated(old interface{}) (string, bool)
+	ownerIndexAliases(name string)
+}
+
+// ownedEnum is implemented by enums that can be bound to the set they are registered in
+type ownedEnum interface {
+	bindOwner(owner enumOwner)
+}
+
+// bindOwner binds the enum to the first set it is registered in and marks it as held by a set
+func (e *EnumBase) bindOwner(owner enumOwner) {
+	if e == nil {
+		return
+	}
+	if e.owner == nil {
+		e.owner = owner
+	}
+	e.held = true
+}
+
+// SetJSONConfig sets the JSON configuration used by all enums first registered in this set,
+// unless they have their own configuration, and returns the EnumSet for chaining
+func (es *EnumSet[T]) SetJSONConfig(config *EnumJSONConfig) *EnumSet[T] {
+	es.jsonConfig = config
+	return es
+}
+
+// JSONConfig returns the JSON configuration of the set
+func (es *EnumSet[T]) JSONConfig() *EnumJSONConfig {
+	if es.jsonConfig == nil {
+		return DefaultJSONConfig()
+	}
+	return es.jsonConfig
+}
+
+// ownerJSONConfig implements enumOwner
+func (es *EnumSet[T]) ownerJSONConfig() *EnumJSONConfig {
+	return es.jsonConfig
+}
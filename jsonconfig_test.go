@@ -0,0 +1,76 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetJSONConfig(t *testing.T) {
+	newConfiguredSet := func() (*EnumSet[TestEnum], TestEnum, TestEnum) {
+		first := TestEnum{NewEnumBase(1, "FIRST", "First value")}
+		second := TestEnum{NewEnumBase(2, "SECOND", "Second value")}
+		return NewEnumSet[TestEnum]().Register(first).Register(second), first, second
+	}
+
+	t.Run("default configuration", func(t *testing.T) {
+		set, first, _ := newConfiguredSet()
+		assert.Equal(t, JSONFormatName, set.JSONConfig().Format)
+		data, err := json.Marshal(first)
+		assert.NoError(t, err)
+		assert.Equal(t, `"FIRST"`, string(data))
+	})
+
+	t.Run("set-level configuration", func(t *testing.T) {
+		set, first, second := newConfiguredSet()
+		set.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
+
+		data, err := json.Marshal(struct {
+			A TestEnum `json:"a"`
+			B TestEnum `json:"b"`
+		}{first, second})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":1,"b":2}`, string(data), "all enums of the set should follow the set configuration")
+	})
+
+	t.Run("value-level override", func(t *testing.T) {
+		set, first, second := newConfiguredSet()
+		set.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
+		first.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatName})
+
+		data, _ := json.Marshal(first)
+		assert.Equal(t, `"FIRST"`, string(data), "enum configuration should override set configuration")
+		data, _ = json.Marshal(second)
+		assert.Equal(t, `2`, string(data))
+
+		first.SetJSONConfig(nil)
+		data, _ = json.Marshal(first)
+		assert.Equal(t, `1`, string(data), "clearing enum configuration should fall back to set configuration")
+	})
+
+	t.Run("first registration wins", func(t *testing.T) {
+		set, first, _ := newConfiguredSet()
+		other := NewEnumSet[TestEnum]().Register(first).SetJSONConfig(&EnumJSONConfig{Format: JSONFormatFull})
+		set.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
+
+		data, _ := json.Marshal(first)
+		assert.Equal(t, `1`, string(data), "enum should follow the set it was first registered in")
+		assert.Equal(t, JSONFormatFull, other.JSONConfig().Format)
+	})
+
+	t.Run("per-call configuration", func(t *testing.T) {
+		_, first, _ := newConfiguredSet()
+		data, err := first.MarshalJSONWithConfig(&EnumJSONConfig{Format: JSONFormatFull})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"FIRST","value":1,"description":"First value"}`, string(data))
+
+		data, _ = json.Marshal(first)
+		assert.Equal(t, `"FIRST"`, string(data), "per-call configuration should not change the enum")
+
+		decoded := &EnumBase{}
+		assert.NoError(t, decoded.UnmarshalJSONWithConfig([]byte(`7`), &EnumJSONConfig{Format: JSONFormatValue}))
+		assert.Equal(t, 7, decoded.Value())
+		assert.Equal(t, JSONFormatName, decoded.GetJSONConfig().Format, "per-call configuration should not be stored")
+	})
+}
@@ -0,0 +1,25 @@
+package goenum
+
+// Key is a comparable identity of an enum by name and value. Enum wrappers hold pointers,
+// so as map keys they compare by identity; map[Key] groups enums by their logical value
+// instead, e.g. enums decoded from JSON with the ones they were registered as.
+type Key struct {
+	// Name is the name of the enum
+	Name string
+	// Value is the JSON encoding of the value of the enum, so 1, int64(1) and a float64 1
+	// decoded from JSON are the same key
+	Value string
+}
+
+// Key returns the comparable key of the enum. A nil enum has the zero Key.
+func (e *EnumBase) Key() Key {
+	if e == nil {
+		return Key{}
+	}
+	return Key{Name: e.name, Value: encodedValue(e.value)}
+}
+
+// String returns the key as "NAME=value"
+func (k Key) String() string {
+	return k.Name + "=" + k.Value
+}
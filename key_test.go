@@ -0,0 +1,35 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey(t *testing.T) {
+	registered := NewEnumBase(1, "ACTIVE", "Active")
+	decoded := NewEnumBase(float64(1), "ACTIVE", "Active")
+
+	t.Run("groups equal enums", func(t *testing.T) {
+		counts := map[Key]int{}
+		for _, enum := range []*EnumBase{registered, decoded, NewEnumBase(2, "CLOSED", "Closed")} {
+			counts[enum.Key()]++
+		}
+		assert.Equal(t, 2, counts[registered.Key()])
+		assert.Len(t, counts, 2)
+	})
+
+	t.Run("distinguishes values", func(t *testing.T) {
+		assert.NotEqual(t, registered.Key(), NewEnumBase("1", "ACTIVE", "Active").Key())
+		assert.NotEqual(t, registered.Key(), NewEnumBase(2, "ACTIVE", "Active").Key())
+	})
+
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, "ACTIVE=1", registered.Key().String())
+		assert.Equal(t, `ACTIVE="1"`, NewEnumBase("1", "ACTIVE", "Active").Key().String())
+	})
+
+	t.Run("nil enum", func(t *testing.T) {
+		assert.Equal(t, Key{}, (*EnumBase)(nil).Key())
+	})
+}
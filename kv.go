@@ -0,0 +1,109 @@
+package goenum
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// KVSource is a remote key-value store holding an enum catalog as a JSON array of
+// EnumDefinition. EtcdSource and ConsulSource implement it over the stores' HTTP APIs.
+type KVSource interface {
+	// Get returns the current catalog and its revision
+	Get(ctx context.Context) (data []byte, revision uint64, err error)
+	// Wait blocks until the catalog changes after revision and returns the new catalog.
+	// A change that cannot be read, like a deleted key, returns an error with its
+	// revision so that watching resumes after it. A watch the store cancels, e.g.
+	// because revision was compacted, returns an error wrapping ErrWatchCanceled.
+	Wait(ctx context.Context, revision uint64) (data []byte, newRevision uint64, err error)
+}
+
+// ErrWatchCanceled is wrapped by KVSource.Wait errors when the store cancels the watch,
+// e.g. after compacting the revision it started from, so changes may have been missed
+var ErrWatchCanceled = errors.New("watch canceled")
+
+// KVCatalog keeps an enum set in sync with a KVSource, swapping it atomically on changes
+type KVCatalog struct {
+	// Source holds the catalog
+	Source KVSource
+	// Options validate the loaded definitions; nil uses DefaultValidationOptions
+	Options *ValidationOptions
+	// RetryInterval is the pause after a failed watch; zero means one second
+	RetryInterval time.Duration
+
+	current  atomic.Pointer[EnumSet[Enum]]
+	revision atomic.Uint64
+}
+
+// Load reads the catalog and atomically replaces the current set, which is left
+// unchanged on error
+func (c *KVCatalog) Load(ctx context.Context) (*EnumSet[Enum], error) {
+	data, revision, err := c.Source.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.apply(data, revision)
+}
+
+// EnumSet returns the current set, or nil before the first successful Load
+func (c *KVCatalog) EnumSet() *EnumSet[Enum] {
+	return c.current.Load()
+}
+
+// Revision returns the source revision of the last catalog read
+func (c *KVCatalog) Revision() uint64 {
+	return c.revision.Load()
+}
+
+// Watch applies every change of the source until ctx is done. Errors are passed to
+// onError, if not nil; invalid catalogs keep the previous set. When the source cancels
+// the watch, the catalog is reloaded with Get and watched from the revision of that read.
+func (c *KVCatalog) Watch(ctx context.Context, onError func(error)) error {
+	retry := c.RetryInterval
+	if retry == 0 {
+		retry = time.Second
+	}
+	for {
+		data, revision, err := c.Source.Wait(ctx, c.revision.Load())
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil {
+			if _, err := c.apply(data, revision); err != nil && onError != nil {
+				onError(err)
+			}
+			continue
+		}
+		if errors.Is(err, ErrWatchCanceled) {
+			if _, err = c.Load(ctx); err == nil {
+				continue
+			}
+		} else if revision > c.revision.Load() {
+			c.revision.Store(revision)
+		}
+		if onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retry):
+		}
+	}
+}
+
+// apply loads data as the catalog at revision. The revision advances even when the
+// catalog is invalid, so watching resumes with the next change.
+func (c *KVCatalog) apply(data []byte, revision uint64) (*EnumSet[Enum], error) {
+	c.revision.Store(revision)
+	loader := NewDynamicEnumLoader(c.Options)
+	if err := loader.LoadFromReader(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("invalid catalog at revision %d: %w", revision, err)
+	}
+	set := loader.GetEnumSet()
+	c.current.Store(set)
+	return set, nil
+}
@@ -0,0 +1,117 @@
+package goenum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// kvChange is a change delivered by fakeKVSource
+type kvChange struct {
+	data     string
+	revision uint64
+	err      error
+}
+
+// fakeKVSource serves a catalog and delivers changes sent on its channel
+type fakeKVSource struct {
+	data     string
+	revision uint64
+	changes  chan kvChange
+	waited   []uint64
+}
+
+func (f *fakeKVSource) Get(context.Context) ([]byte, uint64, error) {
+	return []byte(f.data), f.revision, nil
+}
+
+func (f *fakeKVSource) Wait(ctx context.Context, revision uint64) ([]byte, uint64, error) {
+	f.waited = append(f.waited, revision)
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	case change := <-f.changes:
+		return []byte(change.data), change.revision, change.err
+	}
+}
+
+func TestKVCatalog(t *testing.T) {
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+
+	t.Run("Load()", func(t *testing.T) {
+		catalog := &KVCatalog{Source: &fakeKVSource{data: `[{"name": "A", "value": 1}]`, revision: 7}, Options: options}
+		assert.Nil(t, catalog.EnumSet(), "EnumSet() should be nil before loading")
+
+		set, err := catalog.Load(context.Background())
+		assert.NoError(t, err)
+		assert.Same(t, set, catalog.EnumSet())
+		assert.Equal(t, uint64(7), catalog.Revision())
+	})
+
+	t.Run("Watch() swaps sets and skips failures", func(t *testing.T) {
+		source := &fakeKVSource{data: `[{"name": "A", "value": 1}]`, revision: 1, changes: make(chan kvChange)}
+		catalog := &KVCatalog{Source: source, Options: options, RetryInterval: time.Millisecond}
+		_, err := catalog.Load(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		var errs []error
+		go func() {
+			done <- catalog.Watch(ctx, func(err error) { errs = append(errs, err) })
+		}()
+
+		source.changes <- kvChange{data: `[{"name": "A", "value": 1}, {"name": "B", "value": 2}]`, revision: 2}
+		source.changes <- kvChange{data: `broken`, revision: 3}
+		source.changes <- kvChange{revision: 4, err: errors.New("key deleted")}
+		source.changes <- kvChange{err: errors.New("connection refused")}
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("Watch() should return when the context is canceled")
+		}
+		assert.ElementsMatch(t, []string{"A", "B"}, catalog.EnumSet().Names(), "the last valid catalog should be current")
+		assert.Equal(t, uint64(4), catalog.Revision(), "failed changes should still advance the revision")
+		assert.Len(t, errs, 3)
+		assert.ErrorContains(t, errs[0], "invalid catalog at revision 3")
+	})
+
+	t.Run("Watch() reloads after compaction", func(t *testing.T) {
+		source := &fakeKVSource{data: `[{"name": "A", "value": 1}]`, revision: 1, changes: make(chan kvChange)}
+		catalog := &KVCatalog{Source: source, Options: options, RetryInterval: time.Millisecond}
+		_, err := catalog.Load(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		var errs []error
+		go func() {
+			done <- catalog.Watch(ctx, func(err error) { errs = append(errs, err) })
+		}()
+
+		// Revisions 2 to 9 were compacted away while the watch was down
+		source.data, source.revision = `[{"name": "A", "value": 1}, {"name": "C", "value": 3}]`, 10
+		source.changes <- kvChange{err: fmt.Errorf("etcd %w: revision 2 was compacted", ErrWatchCanceled)}
+		source.changes <- kvChange{data: `[{"name": "D", "value": 4}]`, revision: 11}
+		source.changes <- kvChange{err: errors.New("connection refused")}
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("Watch() should return when the context is canceled")
+		}
+		assert.Equal(t, []string{"D"}, catalog.EnumSet().Names(), "watching should resume after the reload")
+		assert.Equal(t, uint64(11), catalog.Revision())
+		assert.Equal(t, []uint64{1, 10, 11}, source.waited[:3], "watching should resume from the revision of the reload")
+		assert.Len(t, errs, 1, "a recovered compaction should not be reported")
+	})
+}
@@ -0,0 +1,14 @@
+package goenum
+
+import "sync"
+
+// Lazy returns an accessor that calls build on first use and returns the same set on
+// every call, safe for concurrent use. Declaring a set as
+//
+//	var Statuses = goenum.Lazy(func() *goenum.EnumSet[Status] { ... })
+//
+// avoids init() ordering issues between packages and defers the cost of registration
+// until Statuses() is first called. If build panics, every call panics with the same value.
+func Lazy[T Enum](build func() *EnumSet[T]) func() *EnumSet[T] {
+	return sync.OnceValue(build)
+}
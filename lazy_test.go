@@ -0,0 +1,40 @@
+package goenum
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	t.Run("builds once on first use", func(t *testing.T) {
+		builds := 0
+		statuses := Lazy(func() *EnumSet[TestEnum] {
+			builds++
+			return NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB)
+		})
+		assert.Zero(t, builds, "Lazy() should not build the set")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, exists := statuses().GetByName("A")
+				assert.True(t, exists)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, builds)
+		assert.Same(t, statuses(), statuses())
+	})
+
+	t.Run("repeats build panics", func(t *testing.T) {
+		statuses := Lazy(func() *EnumSet[TestEnum] {
+			return NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumA)
+		})
+		assert.Panics(t, func() { statuses() })
+		assert.Panics(t, func() { statuses() })
+	})
+}
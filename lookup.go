@@ -0,0 +1,130 @@
+package goenum
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the number of nearest matches reported by an UnknownEnumError
+const maxSuggestions = 3
+
+// GetByNameE retrieves an enum by its name or alias like GetByName, returning an
+// *UnknownEnumError naming the set and the nearest names and aliases if none matches
+func (es *EnumSet[T]) GetByNameE(name string) (T, error) {
+	if enum, exists := es.GetByName(name); exists {
+		return enum, nil
+	}
+
+	candidates := make([]string, 0, len(es.values))
+	for _, enum := range es.Values() {
+		candidates = append(candidates, enum.String())
+		candidates = append(candidates, enum.Aliases()...)
+	}
+	var zero T
+	return zero, es.lookupError(name, candidates)
+}
+
+// GetByValueE retrieves an enum by its value like GetByValue, returning an
+// *UnknownEnumError naming the set and the nearest values if none matches
+func (es *EnumSet[T]) GetByValueE(value interface{}) (T, error) {
+	if enum, exists := es.GetByValue(value); exists {
+		return enum, nil
+	}
+
+	candidates := make([]string, 0, len(es.byValue))
+	for v := range es.byValue {
+		candidates = append(candidates, fmt.Sprint(v))
+	}
+	var zero T
+	return zero, es.lookupError(fmt.Sprint(value), candidates)
+}
+
+// lookupError builds an UnknownEnumError for input with the set name and the
+// candidates nearest to input
+func (es *EnumSet[T]) lookupError(input string, candidates []string) *UnknownEnumError {
+	err := newUnknownEnumError(input, es)
+	err.Set = es.setName()
+	err.Suggestions = nearestMatches(input, candidates, maxSuggestions)
+	return err
+}
+
+// setName returns the name the set is registered under in the global registry,
+// or the name of its enum type, qualified with its namespace, when it is not registered
+func (es *EnumSet[T]) setName() string {
+	registry.RLock()
+	defer registry.RUnlock()
+	var names []string
+	for name, set := range registry.sets {
+		if registered, ok := set.(*EnumSet[T]); ok && registered == es {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return qualifySetName(es, typeName[T]())
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+// nearestMatches returns up to limit candidates within a small edit distance of input,
+// ignoring case, closest first
+func nearestMatches(input string, candidates []string, limit int) []string {
+	type match struct {
+		candidate string
+		distance  int
+	}
+	input = strings.ToUpper(input)
+	maxDistance := len(input)/3 + 1
+
+	var matches []match
+	seen := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		if seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		upper := strings.ToUpper(candidate)
+		distance := editDistance(input, upper)
+		if distance <= maxDistance || (input != "" && strings.HasPrefix(upper, input)) {
+			matches = append(matches, match{candidate, distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].candidate < matches[j].candidate
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	result := make([]string, len(matches))
+	for i, m := range matches {
+		result[i] = m.candidate
+	}
+	return result
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	previous := make([]int, len(br)+1)
+	current := make([]int, len(br)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		current[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(br)]
+}
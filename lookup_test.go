@@ -0,0 +1,59 @@
+package goenum
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorReturningLookups(t *testing.T) {
+	set := NewEnumSet[TestEnum]()
+	set.Register(TestEnum{NewEnumBase(1, "ACTIVE", "Active", "RUNNING")}).
+		Register(TestEnum{NewEnumBase(2, "INACTIVE", "Inactive")}).
+		Register(TestEnum{NewEnumBase(3, "PAUSED", "Paused")})
+
+	t.Run("GetByNameE()", func(t *testing.T) {
+		enum, err := set.GetByNameE("running")
+		assert.NoError(t, err)
+		assert.Equal(t, "ACTIVE", enum.String())
+
+		_, err = set.GetByNameE("ACTIV")
+		assert.True(t, errors.Is(err, ErrUnknownEnum))
+		assert.EqualError(t, err, `unknown enum "ACTIV" in TestEnum, did you mean ACTIVE? (allowed: ACTIVE, INACTIVE, PAUSED)`)
+
+		var unknownErr *UnknownEnumError
+		assert.True(t, errors.As(err, &unknownErr))
+		assert.Equal(t, []string{"ACTIVE"}, unknownErr.Suggestions)
+
+		_, err = set.GetByNameE("runing")
+		assert.True(t, errors.As(err, &unknownErr))
+		assert.Equal(t, []string{"RUNNING"}, unknownErr.Suggestions, "aliases should be suggested")
+
+		_, err = set.GetByNameE("DELETED")
+		assert.True(t, errors.As(err, &unknownErr))
+		assert.Empty(t, unknownErr.Suggestions, "distant names should not be suggested")
+	})
+
+	t.Run("GetByValueE()", func(t *testing.T) {
+		enum, err := set.GetByValueE(2)
+		assert.NoError(t, err)
+		assert.Equal(t, "INACTIVE", enum.String())
+
+		_, err = set.GetByValueE(4)
+		var unknownErr *UnknownEnumError
+		assert.True(t, errors.As(err, &unknownErr))
+		assert.Equal(t, "4", unknownErr.Input)
+		assert.Equal(t, []string{"1", "2", "3"}, unknownErr.Suggestions)
+	})
+
+	t.Run("registered set name", func(t *testing.T) {
+		RegisterSet("Statuses", set)
+		t.Cleanup(func() { UnregisterSet("Statuses") })
+
+		_, err := set.GetByNameE("X")
+		var unknownErr *UnknownEnumError
+		assert.True(t, errors.As(err, &unknownErr))
+		assert.Equal(t, "Statuses", unknownErr.Set)
+	})
+}
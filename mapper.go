@@ -0,0 +1,128 @@
+package goenum
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MappingStrategy finds the enum of dst corresponding to an enum of another set
+type MappingStrategy func(src Enum, dst AnyEnumSet) (Enum, bool)
+
+// MapByName maps an enum to the enum of dst with the same name, or an alias equal to it
+func MapByName(src Enum, dst AnyEnumSet) (Enum, bool) {
+	return dst.EnumByName(src.String())
+}
+
+// MapByValue maps an enum to the enum of dst with the same value
+func MapByValue(src Enum, dst AnyEnumSet) (Enum, bool) {
+	return dst.EnumByValue(src.Value())
+}
+
+// MapByTable returns a MappingStrategy mapping source names to the names or aliases in dst
+// given by table, e.g. {"IN_PROGRESS": "PROCESSING"}
+func MapByTable(table map[string]string) MappingStrategy {
+	upper := make(map[string]string, len(table))
+	for src, dst := range table {
+		upper[strings.ToUpper(src)] = dst
+	}
+	return func(src Enum, dst AnyEnumSet) (Enum, bool) {
+		name, exists := upper[strings.ToUpper(src.String())]
+		if !exists {
+			return nil, false
+		}
+		return dst.EnumByName(name)
+	}
+}
+
+// EnumMapper translates the enums of one set into another, e.g. internal statuses into the
+// statuses of a partner API. The mapping is computed when the mapper is created.
+type EnumMapper[S Enum, D Enum] struct {
+	forward  map[string]D
+	reverse  map[string][]S
+	unmapped []S
+}
+
+// NewEnumMapper maps every enum of src to an enum of dst using strategy
+func NewEnumMapper[S Enum, D Enum](src *EnumSet[S], dst *EnumSet[D], strategy MappingStrategy) *EnumMapper[S, D] {
+	m := &EnumMapper[S, D]{
+		forward: make(map[string]D),
+		reverse: make(map[string][]S),
+	}
+	for _, enum := range src.Values() {
+		mapped, exists := strategy(enum, dst)
+		target, ok := mapped.(D)
+		if !exists || !ok {
+			m.unmapped = append(m.unmapped, enum)
+			continue
+		}
+		m.forward[enum.String()] = target
+		m.reverse[target.String()] = append(m.reverse[target.String()], enum)
+	}
+	return m
+}
+
+// Map returns the enum of the destination set that enum maps to
+func (m *EnumMapper[S, D]) Map(enum S) (D, bool) {
+	target, exists := m.forward[enum.String()]
+	return target, exists
+}
+
+// MapE returns the enum of the destination set that enum maps to, or an error if it has no mapping
+func (m *EnumMapper[S, D]) MapE(enum S) (D, error) {
+	target, exists := m.forward[enum.String()]
+	if !exists {
+		return target, fmt.Errorf("enum %s has no mapping", enum.String())
+	}
+	return target, nil
+}
+
+// Reverse returns the enum of the source set mapped to enum, if exactly one is
+func (m *EnumMapper[S, D]) Reverse(enum D) (S, bool) {
+	sources := m.reverse[enum.String()]
+	if len(sources) != 1 {
+		var zero S
+		return zero, false
+	}
+	return sources[0], true
+}
+
+// Unmapped returns the enums of the source set without a mapping, in registration order
+func (m *EnumMapper[S, D]) Unmapped() []S {
+	return append([]S(nil), m.unmapped...)
+}
+
+// Complete returns an error listing the enums of the source set without a mapping, if any
+func (m *EnumMapper[S, D]) Complete() error {
+	if len(m.unmapped) == 0 {
+		return nil
+	}
+	names := make([]string, len(m.unmapped))
+	for i, enum := range m.unmapped {
+		names[i] = enum.String()
+	}
+	return fmt.Errorf("enums without mapping: %s", strings.Join(names, ", "))
+}
+
+// VerifyRoundTrip returns an error if the mapping is incomplete or several enums of the
+// source set map to the same enum, so that mapping back with Reverse is not possible
+func (m *EnumMapper[S, D]) VerifyRoundTrip() error {
+	if err := m.Complete(); err != nil {
+		return err
+	}
+	var collisions []string
+	for target, sources := range m.reverse {
+		if len(sources) > 1 {
+			names := make([]string, len(sources))
+			for i, enum := range sources {
+				names[i] = enum.String()
+			}
+			collisions = append(collisions, fmt.Sprintf("%s <- %s", target, strings.Join(names, ", ")))
+		}
+	}
+	if len(collisions) == 0 {
+		return nil
+	}
+	sort.Strings(collisions)
+	return fmt.Errorf("enums mapped to the same enum: %s", strings.Join(collisions, "; "))
+}
@@ -0,0 +1,66 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumMapper(t *testing.T) {
+	internal := NewEnumSet[TestEnum]().
+		Register(TestEnum{NewEnumBase(1, "PENDING", "")}).
+		Register(TestEnum{NewEnumBase(2, "IN_PROGRESS", "")}).
+		Register(TestEnum{NewEnumBase(3, "DONE", "")}).
+		Register(TestEnum{NewEnumBase(4, "ARCHIVED", "")})
+	partner := NewEnumSet[*EnumBase]().
+		Register(NewEnumBase("new", "PENDING", "")).
+		Register(NewEnumBase("processing", "PROCESSING", "", "IN_PROGRESS")).
+		Register(NewEnumBase("completed", "DONE", ""))
+	inProgress, _ := internal.GetByName("IN_PROGRESS")
+	archived, _ := internal.GetByName("ARCHIVED")
+
+	t.Run("by name", func(t *testing.T) {
+		mapper := NewEnumMapper(internal, partner, MapByName)
+		target, exists := mapper.Map(inProgress)
+		assert.True(t, exists)
+		assert.Equal(t, "PROCESSING", target.String(), "aliases should be matched")
+
+		_, exists = mapper.Map(archived)
+		assert.False(t, exists)
+		_, err := mapper.MapE(archived)
+		assert.EqualError(t, err, "enum ARCHIVED has no mapping")
+		assert.Equal(t, []TestEnum{archived}, mapper.Unmapped())
+		assert.EqualError(t, mapper.Complete(), "enums without mapping: ARCHIVED")
+
+		source, exists := mapper.Reverse(target)
+		assert.True(t, exists)
+		assert.Equal(t, inProgress, source)
+	})
+
+	t.Run("by value", func(t *testing.T) {
+		codes := NewEnumSet[*EnumBase]().
+			Register(NewEnumBase(1, "P", "")).
+			Register(NewEnumBase(2, "I", "")).
+			Register(NewEnumBase(3, "D", "")).
+			Register(NewEnumBase(4, "A", ""))
+		mapper := NewEnumMapper(internal, codes, MapByValue)
+		assert.NoError(t, mapper.VerifyRoundTrip())
+		target, _ := mapper.Map(archived)
+		assert.Equal(t, "A", target.String())
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		mapper := NewEnumMapper(internal, partner, MapByTable(map[string]string{
+			"pending":     "PENDING",
+			"IN_PROGRESS": "PROCESSING",
+			"DONE":        "DONE",
+			"ARCHIVED":    "DONE",
+		}))
+		assert.NoError(t, mapper.Complete())
+		assert.EqualError(t, mapper.VerifyRoundTrip(), "enums mapped to the same enum: DONE <- DONE, ARCHIVED")
+
+		done, _ := partner.GetByName("DONE")
+		_, exists := mapper.Reverse(done)
+		assert.False(t, exists, "Reverse() should fail for ambiguous mappings")
+	})
+}
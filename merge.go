@@ -0,0 +1,120 @@
+package goenum
+
+import "fmt"
+
+// MergeStrategy decides what Merge does with an enum whose name or value is already
+// registered in the set
+type MergeStrategy struct {
+	conflict mergeConflict
+	prefix   string
+}
+
+// mergeConflict is the way a MergeStrategy resolves conflicts
+type mergeConflict int
+
+const (
+	mergeError mergeConflict = iota
+	mergeSkip
+	mergeOverride
+	mergeRename
+)
+
+var (
+	// MergeError fails the merge on the first conflict, leaving the set unchanged
+	MergeError = MergeStrategy{conflict: mergeError}
+	// MergeSkip keeps the enums already in the set and drops the conflicting ones
+	MergeSkip = MergeStrategy{conflict: mergeSkip}
+	// MergeOverride replaces the enums of the set that share a name or value with an
+	// enum being merged
+	MergeOverride = MergeStrategy{conflict: mergeOverride}
+)
+
+// MergeRenameWithPrefix registers enums whose name is already in the set under the name
+// with prefix prepended, and their aliases likewise. Renamed enums are rebuilt from their
+// definitions, so T must be *EnumBase or a struct, or pointer to a struct, embedding
+// *EnumBase. A value conflict cannot be renamed away and fails the merge.
+func MergeRenameWithPrefix(prefix string) MergeStrategy {
+	return MergeStrategy{conflict: mergeRename, prefix: prefix}
+}
+
+// Merge registers the enums of other in the set in their registration order, resolving
+// conflicts with strategy, e.g. to lay environment-specific overlays over a base catalog.
+// Either every enum is merged or the first error is returned and the set is unchanged.
+func (es *EnumSet[T]) Merge(other *EnumSet[T], strategy MergeStrategy) error {
+	if other == nil {
+		return nil
+	}
+
+	// Plan the merge on a scratch copy, so that a failure leaves the set untouched
+	scratch := es.clone()
+	var removed []string
+	var added []T
+	for _, enum := range other.Values() {
+		conflicts := scratch.conflictsWith(enum)
+		if len(conflicts) > 0 {
+			switch strategy.conflict {
+			case mergeSkip:
+				continue
+			case mergeOverride:
+				for _, name := range conflicts {
+					scratch.Unregister(name)
+					removed = append(removed, name)
+				}
+			case mergeRename:
+				if _, exists := scratch.values[enum.String()]; exists {
+					renamed, err := renameEnum(enum, strategy.prefix)
+					if err != nil {
+						return fmt.Errorf("enum %s: %w", enum.String(), err)
+					}
+					enum = renamed
+				}
+			}
+		}
+		if err := scratch.checkRegister(enum); err != nil {
+			return fmt.Errorf("enum %s: %w", enum.String(), err)
+		}
+		scratch.add(enum)
+		added = append(added, enum)
+	}
+
+	for _, name := range removed {
+		es.Unregister(name)
+	}
+	for _, enum := range added {
+		if err := es.TryRegister(enum); err != nil {
+			return fmt.Errorf("enum %s: %w", enum.String(), err)
+		}
+	}
+	return nil
+}
+
+// conflictsWith returns the names of the enums in the set sharing a name or value with enum
+func (es *EnumSet[T]) conflictsWith(enum T) []string {
+	var names []string
+	if _, exists := es.values[enum.String()]; exists {
+		names = append(names, enum.String())
+	}
+	if registered, exists := es.byValue[enum.Value()]; exists && registered.String() != enum.String() {
+		names = append(names, registered.String())
+	}
+	return names
+}
+
+// renameEnum rebuilds enum from its definition with prefix prepended to its name and aliases
+func renameEnum[T Enum](enum T, prefix string) (T, error) {
+	def := definitionOf(enum)
+	aliases := make([]string, len(def.Aliases))
+	for i, alias := range def.Aliases {
+		aliases[i] = prefix + alias
+	}
+	return enumOf[T](&EnumBase{
+		name:        prefix + def.Name,
+		value:       def.Value,
+		description: def.Description,
+		aliases:     aliases,
+		group:       def.Group,
+		displayName: def.DisplayName,
+		meta:        def.Meta,
+		tags:        def.Tags,
+	})
+}
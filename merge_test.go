@@ -0,0 +1,73 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	base := func() *EnumSet[*EnumBase] {
+		set := NewEnumSet[*EnumBase]()
+		set.Register(NewEnumBase(1, "ACTIVE", "Active", "on"))
+		set.Register(NewEnumBase(2, "CLOSED", "Closed"))
+		return set
+	}
+	names := func(set *EnumSet[*EnumBase]) []string {
+		var names []string
+		for _, enum := range set.Values() {
+			names = append(names, enum.String())
+		}
+		return names
+	}
+	overlay := NewEnumSet[*EnumBase]()
+	overlay.Register(NewEnumBase(1, "ACTIVE", "Active in staging", "on"))
+	overlay.Register(NewEnumBase(3, "PAUSED", "Paused"))
+
+	t.Run("MergeError", func(t *testing.T) {
+		set := base()
+		err := set.Merge(overlay, MergeError)
+		assert.EqualError(t, err, "enum ACTIVE: duplicate enum name: ACTIVE")
+		assert.Equal(t, []string{"ACTIVE", "CLOSED"}, names(set), "a failed merge should leave the set unchanged")
+
+		assert.NoError(t, set.Merge(nil, MergeError))
+	})
+
+	t.Run("MergeSkip", func(t *testing.T) {
+		set := base()
+		assert.NoError(t, set.Merge(overlay, MergeSkip))
+		assert.Equal(t, []string{"ACTIVE", "CLOSED", "PAUSED"}, names(set))
+		active, _ := set.GetByName("ACTIVE")
+		assert.Equal(t, "Active", active.Description())
+	})
+
+	t.Run("MergeOverride", func(t *testing.T) {
+		set := base()
+		assert.NoError(t, set.Merge(overlay, MergeOverride))
+		assert.Equal(t, []string{"CLOSED", "ACTIVE", "PAUSED"}, names(set))
+		active, _ := set.GetByName("on")
+		assert.Equal(t, "Active in staging", active.Description())
+
+		renumbered := NewEnumSet[*EnumBase]()
+		renumbered.Register(NewEnumBase(2, "SHUT", "Closed"))
+		set = base()
+		assert.NoError(t, set.Merge(renumbered, MergeOverride))
+		assert.Equal(t, []string{"ACTIVE", "SHUT"}, names(set), "value conflicts should be replaced too")
+	})
+
+	t.Run("MergeRenameWithPrefix()", func(t *testing.T) {
+		set := base()
+		other := NewEnumSet[*EnumBase]()
+		other.Register(NewEnumBase(10, "ACTIVE", "Active in staging", "on"))
+		assert.NoError(t, set.Merge(other, MergeRenameWithPrefix("STAGING_")))
+		assert.Equal(t, []string{"ACTIVE", "CLOSED", "STAGING_ACTIVE"}, names(set))
+		renamed, exists := set.GetByName("STAGING_on")
+		assert.True(t, exists)
+		assert.Equal(t, 10, renamed.Value())
+
+		set = base()
+		err := set.Merge(overlay, MergeRenameWithPrefix("STAGING_"))
+		assert.EqualError(t, err, "enum STAGING_ACTIVE: duplicate enum value: 1")
+		assert.Equal(t, []string{"ACTIVE", "CLOSED"}, names(set))
+	})
+}
@@ -0,0 +1,289 @@
+package goenum
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// AnyEnumSet is the type-independent view of an EnumSet
+type AnyEnumSet interface {
+	Names() []string
+	EnumByName(name string) (Enum, bool)
+	EnumByValue(value interface{}) (Enum, bool)
+}
+
+// EnumByName retrieves an enum by its name or alias as a plain Enum
+func (es *EnumSet[T]) EnumByName(name string) (Enum, bool) {
+	enum, exists := es.GetByName(name)
+	if !exists {
+		return nil, false
+	}
+	return enum, true
+}
+
+// EnumByValue retrieves an enum by its value as a plain Enum
+func (es *EnumSet[T]) EnumByValue(value interface{}) (Enum, bool) {
+	enum, exists := es.GetByValue(value)
+	if !exists {
+		return nil, false
+	}
+	return enum, true
+}
+
+// EnumFieldError reports a message field holding a value that is not registered in its set
+type EnumFieldError struct {
+	Field   string
+	Value   interface{}
+	Allowed []string
+}
+
+// Error implements the error interface
+func (e *EnumFieldError) Error() string {
+	return fmt.Sprintf("invalid enum value %v for field %s (allowed: %s)",
+		e.Value, e.Field, strings.Join(e.Allowed, ", "))
+}
+
+// ValidateEnumFields checks that the fields of msg hold values registered in their sets.
+// Fields are addressed by their protobuf name (or json name, or Go name), using dots for
+// nested messages, e.g. "order.status". Repeated fields have every element checked and map
+// fields every value. Integer fields such as protoc-generated enums are matched against int
+// enum values; fields that do not hold scalars are reported as errors.
+func ValidateEnumFields(msg interface{}, fields map[string]AnyEnumSet) error {
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		values, err := messageFieldValues(reflect.ValueOf(msg), strings.Split(path, "."))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", path, err)
+		}
+		set := fields[path]
+		for _, value := range values {
+			if !isScalarKind(value.Kind()) {
+				return fmt.Errorf("field %s: %s is not a scalar", path, value.Type())
+			}
+			if _, exists := lookupFieldValue(set, value); !exists {
+				allowed := set.Names()
+				return &EnumFieldError{Field: path, Value: value.Interface(), Allowed: allowed}
+			}
+		}
+	}
+	return nil
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor rejecting requests whose
+// fields fail ValidateEnumFields before calling the handler. It needs no gRPC import: Info
+// and Handler are instantiated with *grpc.UnaryServerInfo and grpc.UnaryHandler, and the
+// result converts to grpc.UnaryServerInterceptor.
+//
+// onError must translate validation errors to gRPC status errors, e.g. with
+// status.Error(codes.InvalidArgument, err.Error()); if it is nil they are returned
+// unchanged, and gRPC reports them as codes.Unknown.
+func UnaryServerInterceptor[Info any, Handler ~func(context.Context, interface{}) (interface{}, error)](
+	fields map[string]AnyEnumSet, onError func(error) error,
+) func(ctx context.Context, req interface{}, info Info, handler Handler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info Info, handler Handler) (interface{}, error) {
+		if err := validateMessage(req, fields, onError); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ServerStream is the method set of grpc.ServerStream, for metadata type MD metadata.MD
+type ServerStream[MD any] interface {
+	SetHeader(MD) error
+	SendHeader(MD) error
+	SetTrailer(MD)
+	Context() context.Context
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+// StreamServerInterceptor returns a gRPC stream server interceptor checking every message
+// received from the client with ValidateEnumFields, failing RecvMsg for invalid messages.
+// It needs no gRPC import: Info, Handler, Stream and MD are instantiated with
+// *grpc.StreamServerInfo, grpc.StreamHandler, grpc.ServerStream and metadata.MD, and the
+// result converts to grpc.StreamServerInterceptor. onError works as for
+// UnaryServerInterceptor.
+func StreamServerInterceptor[Info any, Handler ~func(interface{}, Stream) error, Stream ServerStream[MD], MD any](
+	fields map[string]AnyEnumSet, onError func(error) error,
+) func(srv interface{}, stream Stream, info Info, handler Handler) error {
+	return func(srv interface{}, stream Stream, info Info, handler Handler) error {
+		validating, ok := any(&validatingStream[MD, Stream]{stream: stream, fields: fields, onError: onError}).(Stream)
+		if !ok {
+			var zero Stream
+			return fmt.Errorf("goenum: stream type %T must be an interface, e.g. grpc.ServerStream", zero)
+		}
+		return handler(srv, validating)
+	}
+}
+
+// validatingStream wraps a server stream, validating the messages it receives
+type validatingStream[MD any, S ServerStream[MD]] struct {
+	stream  S
+	fields  map[string]AnyEnumSet
+	onError func(error) error
+}
+
+func (s *validatingStream[MD, S]) SetHeader(md MD) error    { return s.stream.SetHeader(md) }
+func (s *validatingStream[MD, S]) SendHeader(md MD) error   { return s.stream.SendHeader(md) }
+func (s *validatingStream[MD, S]) SetTrailer(md MD)         { s.stream.SetTrailer(md) }
+func (s *validatingStream[MD, S]) Context() context.Context { return s.stream.Context() }
+func (s *validatingStream[MD, S]) SendMsg(m interface{}) error {
+	return s.stream.SendMsg(m)
+}
+
+// RecvMsg receives the next message and validates its enum fields
+func (s *validatingStream[MD, S]) RecvMsg(m interface{}) error {
+	if err := s.stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(m, s.fields, s.onError)
+}
+
+// validateMessage runs ValidateEnumFields on msg, translating failures with onError
+func validateMessage(msg interface{}, fields map[string]AnyEnumSet, onError func(error) error) error {
+	err := ValidateEnumFields(msg, fields)
+	if err != nil && onError != nil {
+		err = onError(err)
+	}
+	return err
+}
+
+// isScalarKind reports whether values of kind k can be enum values of a message field
+func isScalarKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// lookupFieldValue resolves a field value against the set, converting integers to int
+func lookupFieldValue(set AnyEnumSet, value reflect.Value) (Enum, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if enum, exists := set.EnumByValue(int(value.Int())); exists {
+			return enum, true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		// Values above math.MaxInt would wrap around to negative ints
+		if value.Uint() <= math.MaxInt {
+			if enum, exists := set.EnumByValue(int(value.Uint())); exists {
+				return enum, true
+			}
+		}
+	case reflect.String:
+		if enum, exists := set.EnumByName(value.String()); exists {
+			return enum, true
+		}
+	}
+	return set.EnumByValue(value.Interface())
+}
+
+// messageFieldValues walks path through v and returns the values found at its end,
+// expanding repeated and map fields. Unset nested messages yield no values.
+func messageFieldValues(v reflect.Value, path []string) ([]reflect.Value, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if len(path) == 0 {
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array || v.Kind() == reflect.Map {
+			return elementsOf(v), nil
+		}
+		return []reflect.Value{v}, nil
+	}
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Map {
+		values := make([]reflect.Value, 0)
+		for _, element := range elementsOf(v) {
+			found, err := messageFieldValues(element, path)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, found...)
+		}
+		return values, nil
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a message", v.Type())
+	}
+
+	field, ok := messageField(v, path[0])
+	if !ok {
+		return nil, fmt.Errorf("no field named %s in %s", path[0], v.Type())
+	}
+	return messageFieldValues(field, path[1:])
+}
+
+// elementsOf returns the elements of a slice or array, or the values of a map ordered by
+// key, with pointer elements dereferenced and nil elements left out
+func elementsOf(v reflect.Value) []reflect.Value {
+	var elements []reflect.Value
+	if v.Kind() == reflect.Map {
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			elements = append(elements, v.MapIndex(key))
+		}
+	} else {
+		for i := 0; i < v.Len(); i++ {
+			elements = append(elements, v.Index(i))
+		}
+	}
+	values := make([]reflect.Value, 0, len(elements))
+	for _, element := range elements {
+		for element.IsValid() && (element.Kind() == reflect.Ptr || element.Kind() == reflect.Interface) {
+			if element.IsNil() {
+				element = reflect.Value{}
+			} else {
+				element = element.Elem()
+			}
+		}
+		if element.IsValid() {
+			values = append(values, element)
+		}
+	}
+	return values
+}
+
+// messageField finds the exported struct field with the given protobuf, json or Go name
+func messageField(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Name == name || tagOption(field.Tag.Get("protobuf"), "name") == name ||
+			strings.Split(field.Tag.Get("json"), ",")[0] == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// tagOption returns the value of a key=value option in a comma-separated struct tag
+func tagOption(tag, key string) string {
+	for _, option := range strings.Split(tag, ",") {
+		if strings.HasPrefix(option, key+"=") {
+			return option[len(key)+1:]
+		}
+	}
+	return ""
+}
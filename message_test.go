@@ -0,0 +1,248 @@
+package goenum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// protoStatus mimics a protoc-generated enum type
+type protoStatus int32
+
+type protoItem struct {
+	Status protoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=test.Status" json:"status,omitempty"`
+}
+
+type protoOrder struct {
+	Id       string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   protoStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=test.Status" json:"status,omitempty"`
+	History  []protoStatus `protobuf:"varint,3,rep,packed,name=history,proto3,enum=test.Status" json:"history,omitempty"`
+	Item     *protoItem    `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	Label    string        `json:"label"`
+	internal int
+}
+
+func TestValidateEnumFields(t *testing.T) {
+	fields := map[string]AnyEnumSet{
+		"status":      TestEnumSet,
+		"history":     TestEnumSet,
+		"item.status": TestEnumSet,
+	}
+
+	t.Run("valid message", func(t *testing.T) {
+		msg := &protoOrder{Status: 1, History: []protoStatus{2, 3}, Item: &protoItem{Status: 3}}
+		assert.NoError(t, ValidateEnumFields(msg, fields))
+	})
+
+	t.Run("unset nested message", func(t *testing.T) {
+		msg := &protoOrder{Status: 1}
+		assert.NoError(t, ValidateEnumFields(msg, fields), "ValidateEnumFields() should skip unset nested messages")
+	})
+
+	t.Run("out of range value", func(t *testing.T) {
+		err := ValidateEnumFields(&protoOrder{Status: 7}, fields)
+		var fieldErr *EnumFieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "status", fieldErr.Field)
+		assert.Equal(t, protoStatus(7), fieldErr.Value)
+		assert.Equal(t, []string{"A", "B", "C"}, fieldErr.Allowed)
+		assert.Equal(t, "invalid enum value 7 for field status (allowed: A, B, C)", err.Error())
+	})
+
+	t.Run("repeated and nested values", func(t *testing.T) {
+		err := ValidateEnumFields(&protoOrder{Status: 1, History: []protoStatus{1, 9}}, fields)
+		assert.ErrorContains(t, err, "field history")
+
+		err = ValidateEnumFields(&protoOrder{Status: 1, Item: &protoItem{Status: 0}}, fields)
+		assert.ErrorContains(t, err, "field item.status")
+	})
+
+	t.Run("unsigned values above MaxInt", func(t *testing.T) {
+		type wideMessage struct {
+			Status uint64 `json:"status"`
+		}
+		set := NewEnumSet[*EnumBase]().Register(NewEnumBase(-1, "WRAPPED", ""))
+		msg := &wideMessage{Status: math.MaxUint64}
+		assert.Error(t, ValidateEnumFields(msg, map[string]AnyEnumSet{"status": set}), "values should not wrap around to negative ints")
+	})
+
+	t.Run("map fields", func(t *testing.T) {
+		type protoCatalog struct {
+			Statuses map[string]int32       `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+			Items    map[string]*protoItem  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+			Tags     map[string]interface{} `json:"tags,omitempty"`
+		}
+		fields := map[string]AnyEnumSet{"statuses": TestEnumSet, "items.status": TestEnumSet}
+		msg := &protoCatalog{Statuses: map[string]int32{"a": 1, "b": 2}, Items: map[string]*protoItem{"x": {Status: 3}, "y": nil}}
+		assert.NoError(t, ValidateEnumFields(msg, fields), "map values should be checked like repeated elements")
+
+		msg.Statuses["c"] = 8
+		err := ValidateEnumFields(msg, fields)
+		var fieldErr *EnumFieldError
+		assert.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, int32(8), fieldErr.Value)
+
+		msg.Statuses["c"] = 1
+		msg.Items["z"] = &protoItem{Status: 9}
+		assert.ErrorContains(t, ValidateEnumFields(msg, fields), "field items.status")
+
+		msg = &protoCatalog{Tags: map[string]interface{}{"a": []string{"x"}}}
+		assert.EqualError(t, ValidateEnumFields(msg, map[string]AnyEnumSet{"tags": TestEnumSet}),
+			"field tags: []string is not a scalar", "non-scalar values should be rejected, not hashed")
+	})
+
+	t.Run("non-scalar fields", func(t *testing.T) {
+		err := ValidateEnumFields(&protoOrder{Item: &protoItem{}}, map[string]AnyEnumSet{"item": TestEnumSet})
+		assert.EqualError(t, err, "field item: goenum.protoItem is not a scalar")
+	})
+
+	t.Run("string fields by name", func(t *testing.T) {
+		assert.NoError(t, ValidateEnumFields(&protoOrder{Status: 1, Label: "beta"}, map[string]AnyEnumSet{"label": TestEnumSet}))
+		assert.Error(t, ValidateEnumFields(&protoOrder{Status: 1, Label: "zeta"}, map[string]AnyEnumSet{"label": TestEnumSet}))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		err := ValidateEnumFields(&protoOrder{}, map[string]AnyEnumSet{"missing": TestEnumSet})
+		assert.ErrorContains(t, err, "no field named missing")
+	})
+}
+
+// unaryServerInfo, unaryHandler and unaryServerInterceptor mirror *grpc.UnaryServerInfo,
+// grpc.UnaryHandler and grpc.UnaryServerInterceptor
+type unaryServerInfo struct{ FullMethod string }
+
+type unaryHandler func(ctx context.Context, req interface{}) (interface{}, error)
+
+type unaryServerInterceptor func(ctx context.Context, req interface{}, info *unaryServerInfo, handler unaryHandler) (interface{}, error)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	fields := map[string]AnyEnumSet{"status": TestEnumSet, "item.status": TestEnumSet}
+	info := &unaryServerInfo{FullMethod: "/test.Orders/Create"}
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "created", nil
+	}
+
+	t.Run("valid request", func(t *testing.T) {
+		var interceptor unaryServerInterceptor = UnaryServerInterceptor[*unaryServerInfo, unaryHandler](fields, nil)
+		called = false
+		resp, err := interceptor(context.Background(), &protoOrder{Status: 1, Item: &protoItem{Status: 2}}, info, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "created", resp)
+		assert.True(t, called)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		errInvalidArgument := errors.New("invalid argument")
+		var interceptor unaryServerInterceptor = UnaryServerInterceptor[*unaryServerInfo, unaryHandler](fields, func(err error) error {
+			return fmt.Errorf("%w: %v", errInvalidArgument, err)
+		})
+		called = false
+		resp, err := interceptor(context.Background(), &protoOrder{Status: 1, Item: &protoItem{Status: 9}}, info, handler)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, errInvalidArgument, "errors should be translated by onError")
+		assert.ErrorContains(t, err, "field item.status")
+		assert.False(t, called, "the handler should not run for invalid requests")
+	})
+
+	t.Run("untranslated errors", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor[*unaryServerInfo, unaryHandler](fields, nil)
+		_, err := interceptor(context.Background(), &protoOrder{Status: 7}, info, handler)
+		var fieldErr *EnumFieldError
+		assert.ErrorAs(t, err, &fieldErr)
+	})
+}
+
+// metadata, serverStream, streamServerInfo, streamHandler and streamServerInterceptor mirror
+// metadata.MD, grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler and
+// grpc.StreamServerInterceptor
+type metadata map[string][]string
+
+type serverStream interface {
+	SetHeader(metadata) error
+	SendHeader(metadata) error
+	SetTrailer(metadata)
+	Context() context.Context
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+type streamServerInfo struct{ FullMethod string }
+
+type streamHandler func(srv interface{}, stream serverStream) error
+
+type streamServerInterceptor func(srv interface{}, ss serverStream, info *streamServerInfo, handler streamHandler) error
+
+// orderStream receives the queued orders
+type orderStream struct {
+	orders  []*protoOrder
+	trailer metadata
+}
+
+func (s *orderStream) SetHeader(metadata) error  { return nil }
+func (s *orderStream) SendHeader(metadata) error { return nil }
+func (s *orderStream) SetTrailer(md metadata)    { s.trailer = md }
+func (s *orderStream) Context() context.Context  { return context.Background() }
+func (s *orderStream) SendMsg(interface{}) error { return nil }
+func (s *orderStream) RecvMsg(m interface{}) error {
+	if len(s.orders) == 0 {
+		return io.EOF
+	}
+	*m.(*protoOrder), s.orders = *s.orders[0], s.orders[1:]
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	fields := map[string]AnyEnumSet{"status": TestEnumSet}
+	info := &streamServerInfo{FullMethod: "/test.Orders/Import"}
+	errInvalidArgument := errors.New("invalid argument")
+	var interceptor streamServerInterceptor = StreamServerInterceptor[*streamServerInfo, streamHandler, serverStream, metadata](
+		fields, func(err error) error { return fmt.Errorf("%w: %v", errInvalidArgument, err) })
+
+	var received []protoStatus
+	handler := func(srv interface{}, stream serverStream) error {
+		stream.SetTrailer(metadata{"imported": {"yes"}})
+		for {
+			var order protoOrder
+			if err := stream.RecvMsg(&order); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			received = append(received, order.Status)
+		}
+	}
+
+	stream := &orderStream{orders: []*protoOrder{{Status: 1}, {Status: 2}}}
+	assert.NoError(t, interceptor(nil, stream, info, handler))
+	assert.Equal(t, []protoStatus{1, 2}, received)
+	assert.Equal(t, metadata{"imported": {"yes"}}, stream.trailer, "other stream methods should be forwarded")
+
+	received = nil
+	stream = &orderStream{orders: []*protoOrder{{Status: 1}, {Status: 7}, {Status: 2}}}
+	err := interceptor(nil, stream, info, handler)
+	assert.ErrorIs(t, err, errInvalidArgument, "invalid messages should fail RecvMsg")
+	assert.Equal(t, []protoStatus{1}, received)
+}
+
+func TestAnyEnumSet(t *testing.T) {
+	var set AnyEnumSet = TestEnumSet
+	enum, exists := set.EnumByName("alpha")
+	assert.True(t, exists)
+	assert.Equal(t, TestEnumA, enum)
+
+	enum, exists = set.EnumByValue(3)
+	assert.True(t, exists)
+	assert.Equal(t, TestEnumC, enum)
+
+	enum, exists = set.EnumByValue(99)
+	assert.False(t, exists)
+	assert.Nil(t, enum, "EnumByValue() should return nil Enum when not found")
+}
@@ -0,0 +1,114 @@
+package goenum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// metaEnum is implemented by enums carrying metadata
+type metaEnum interface {
+	Meta(key string) (interface{}, bool)
+	Metadata() map[string]interface{}
+}
+
+// metaOf returns the metadata value of an enum under key
+func metaOf(enum Enum, key string) (interface{}, bool) {
+	if m, ok := enum.(metaEnum); ok {
+		return m.Meta(key)
+	}
+	return nil, false
+}
+
+// metadataOf returns all metadata of an enum, or nil if it has none
+func metadataOf(enum Enum) map[string]interface{} {
+	if m, ok := enum.(metaEnum); ok {
+		return m.Metadata()
+	}
+	return nil
+}
+
+// WithMeta attaches a metadata value under key, such as a display order or a pricing
+// tier, and returns the EnumBase for chaining
+func (e *EnumBase) WithMeta(key string, value interface{}) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	if e.meta == nil {
+		e.meta = make(map[string]interface{})
+	}
+	e.meta[key] = value
+	return e
+}
+
+// Meta returns the metadata value stored under key
+func (e *EnumBase) Meta(key string) (interface{}, bool) {
+	if e == nil {
+		return nil, false
+	}
+	value, exists := e.meta[key]
+	return value, exists
+}
+
+// Metadata returns a copy of all metadata of the enum, or nil if it has none
+func (e *EnumBase) Metadata() map[string]interface{} {
+	if e == nil || len(e.meta) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(e.meta))
+	for key, value := range e.meta {
+		result[key] = value
+	}
+	return result
+}
+
+// SortByMeta returns the enums ordered by their metadata under key: numbers numerically
+// before strings lexically, then enums without the key. Ties keep registration order.
+func (es *EnumSet[T]) SortByMeta(key string) []T {
+	result := es.Values()
+	sort.SliceStable(result, func(i, j int) bool {
+		a, aExists := metaOf(result[i], key)
+		b, bExists := metaOf(result[j], key)
+		if !aExists || !bExists {
+			return aExists && !bExists
+		}
+		return lessValue(a, b)
+	})
+	return result
+}
+
+// FilterByMeta returns the enums whose metadata under key equals value, in registration order.
+// Numbers match regardless of their type, so 1 matches a 1.0 loaded from JSON.
+func (es *EnumSet[T]) FilterByMeta(key string, value interface{}) []T {
+	var result []T
+	for _, enum := range es.Values() {
+		if v, exists := metaOf(enum, key); exists && equalMeta(v, value) {
+			result = append(result, enum)
+		}
+	}
+	return result
+}
+
+// lessValue orders enum values and metadata: numbers numerically, before anything
+// else ordered by its formatted value
+func lessValue(a, b interface{}) bool {
+	af, aNumeric := numericValue(a)
+	bf, bNumeric := numericValue(b)
+	switch {
+	case aNumeric && bNumeric:
+		return af < bf
+	case aNumeric != bNumeric:
+		return aNumeric
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// equalMeta compares metadata values, numbers by their numeric value
+func equalMeta(a, b interface{}) bool {
+	af, aNumeric := numericValue(a)
+	bf, bNumeric := numericValue(b)
+	if aNumeric && bNumeric {
+		return af == bf
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,59 @@
+package goenum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeta(t *testing.T) {
+	basic := NewEnumBase(1, "BASIC", "").WithMeta("order", 2).WithMeta("tier", "free")
+	pro := NewEnumBase(2, "PRO", "").WithMeta("order", 1.5).WithMeta("tier", "premium")
+	team := NewEnumBase(3, "TEAM", "").WithMeta("order", 1).WithMeta("tier", "premium")
+	legacy := NewEnumBase(4, "LEGACY", "")
+	set := NewEnumSet[*EnumBase]().Register(basic).Register(pro).Register(team).Register(legacy)
+
+	t.Run("WithMeta()", func(t *testing.T) {
+		tier, exists := pro.Meta("tier")
+		assert.True(t, exists)
+		assert.Equal(t, "premium", tier)
+		_, exists = legacy.Meta("tier")
+		assert.False(t, exists)
+
+		metadata := basic.Metadata()
+		metadata["tier"] = "changed"
+		tier, _ = basic.Meta("tier")
+		assert.Equal(t, "free", tier, "Metadata() should return a copy")
+		assert.Nil(t, legacy.Metadata())
+	})
+
+	t.Run("SortByMeta()", func(t *testing.T) {
+		assert.Equal(t, []*EnumBase{team, pro, basic, legacy}, set.SortByMeta("order"))
+		assert.Equal(t, []*EnumBase{basic, pro, team, legacy}, set.SortByMeta("tier"), "ties should be ordered by name")
+	})
+
+	t.Run("FilterByMeta()", func(t *testing.T) {
+		assert.Equal(t, []*EnumBase{pro, team}, set.FilterByMeta("tier", "premium"))
+		assert.Equal(t, []*EnumBase{team}, set.FilterByMeta("order", 1.0))
+		assert.Empty(t, set.FilterByMeta("tier", "enterprise"))
+	})
+
+	t.Run("definitions", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		err := loader.LoadFromReader(strings.NewReader(`[
+			{"name": "BASIC", "value": 1, "meta": {"order": 2, "tier": "free"}},
+			{"name": "PRO", "value": 2, "meta": {"order": 1, "tier": "premium"}}
+		]`))
+		assert.NoError(t, err)
+		set := loader.GetEnumSet()
+		premium := set.FilterByMeta("tier", "premium")
+		assert.Len(t, premium, 1)
+		assert.Equal(t, "PRO", premium[0].String())
+		assert.Equal(t, "PRO", set.SortByMeta("order")[0].String())
+		assert.Equal(t, map[string]interface{}{"order": float64(1), "tier": "premium"}, definitionOf(premium[0]).Meta)
+
+		err = ValidateDefinitions([]byte(`[{"name": "A", "value": 1, "meta": "premium"}]`))
+		assert.ErrorContains(t, err, "$[0].meta: expected object, got string")
+	})
+}
@@ -0,0 +1,114 @@
+package goenum
+
+import "sync"
+
+// LookupEvent identifies the outcome of an EnumSet lookup
+type LookupEvent int
+
+const (
+	// LookupNameHit is a GetByName call that matched a name
+	LookupNameHit LookupEvent = iota
+	// LookupAliasHit is a GetByName call that matched an alias
+	LookupAliasHit
+	// LookupNameMiss is a GetByName call that matched nothing
+	LookupNameMiss
+	// LookupValueHit is a GetByValue call that matched a value
+	LookupValueHit
+	// LookupValueMiss is a GetByValue call that matched nothing
+	LookupValueMiss
+	// LookupDeprecatedAliasHit is a GetByName call that matched a deprecated alias;
+	// it is reported instead of LookupAliasHit
+	LookupDeprecatedAliasHit
+)
+
+// String returns the event name, suitable as a metrics label
+func (e LookupEvent) String() string {
+	switch e {
+	case LookupNameHit:
+		return "name_hit"
+	case LookupAliasHit:
+		return "alias_hit"
+	case LookupNameMiss:
+		return "name_miss"
+	case LookupValueHit:
+		return "value_hit"
+	case LookupValueMiss:
+		return "value_miss"
+	case LookupDeprecatedAliasHit:
+		return "deprecated_alias_hit"
+	default:
+		return "unknown"
+	}
+}
+
+// LookupMetrics receives an event for every lookup on an EnumSet.
+// key is the canonical name for hits, the alias for alias hits and the raw input for misses.
+type LookupMetrics interface {
+	ObserveLookup(event LookupEvent, key string)
+}
+
+// LookupMetricsFunc adapts a function to the LookupMetrics interface, e.g. to increment a
+// Prometheus CounterVec: vec.WithLabelValues(event.String()).Inc(). Beware of using the key
+// of miss events as a label, since it is arbitrary client input.
+type LookupMetricsFunc func(event LookupEvent, key string)
+
+// ObserveLookup implements LookupMetrics
+func (f LookupMetricsFunc) ObserveLookup(event LookupEvent, key string) {
+	f(event, key)
+}
+
+// SetMetrics installs a metrics hook for lookups and returns the EnumSet for chaining
+func (es *EnumSet[T]) SetMetrics(metrics LookupMetrics) *EnumSet[T] {
+	es.metrics = metrics
+	return es
+}
+
+// observe reports a lookup event to the installed metrics hook, if any
+func (es *EnumSet[T]) observe(event LookupEvent, key string) {
+	if es.metrics != nil {
+		es.metrics.ObserveLookup(event, key)
+	}
+}
+
+// LookupCounter is an in-memory LookupMetrics that counts events per key
+type LookupCounter struct {
+	mu     sync.Mutex
+	counts map[LookupEvent]map[string]int
+}
+
+// NewLookupCounter creates a new LookupCounter
+func NewLookupCounter() *LookupCounter {
+	return &LookupCounter{counts: make(map[LookupEvent]map[string]int)}
+}
+
+// ObserveLookup implements LookupMetrics
+func (c *LookupCounter) ObserveLookup(event LookupEvent, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts[event] == nil {
+		c.counts[event] = make(map[string]int)
+	}
+	c.counts[event][key]++
+}
+
+// Counts returns a copy of the per-key counts recorded for an event
+func (c *LookupCounter) Counts(event LookupEvent) map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make(map[string]int, len(c.counts[event]))
+	for key, count := range c.counts[event] {
+		result[key] = count
+	}
+	return result
+}
+
+// Total returns the number of times an event was recorded
+func (c *LookupCounter) Total(event LookupEvent) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	total := 0
+	for _, count := range c.counts[event] {
+		total += count
+	}
+	return total
+}
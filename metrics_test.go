@@ -0,0 +1,58 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupMetrics(t *testing.T) {
+	newObservedSet := func() (*EnumSet[TestEnum], *LookupCounter) {
+		counter := NewLookupCounter()
+		set := NewEnumSet[TestEnum]().
+			Register(TestEnumA).
+			Register(TestEnumB).
+			Register(TestEnumC).
+			SetMetrics(counter)
+		return set, counter
+	}
+
+	t.Run("name lookups", func(t *testing.T) {
+		set, counter := newObservedSet()
+		set.GetByName("A")
+		set.GetByName("a")
+		set.GetByName("charlie")
+		set.GetByName("unknown")
+		set.GetByName("unknown")
+
+		assert.Equal(t, map[string]int{"A": 2}, counter.Counts(LookupNameHit), "hits should be counted by canonical name")
+		assert.Equal(t, map[string]int{"charlie": 1}, counter.Counts(LookupAliasHit), "alias hits should be counted by alias")
+		assert.Equal(t, map[string]int{"unknown": 2}, counter.Counts(LookupNameMiss), "misses should be counted by input")
+	})
+
+	t.Run("value lookups", func(t *testing.T) {
+		set, counter := newObservedSet()
+		set.GetByValue(2)
+		set.GetByValue(42)
+
+		assert.Equal(t, map[string]int{"B": 1}, counter.Counts(LookupValueHit))
+		assert.Equal(t, map[string]int{"42": 1}, counter.Counts(LookupValueMiss))
+		assert.Equal(t, 1, counter.Total(LookupValueMiss))
+	})
+
+	t.Run("LookupMetricsFunc adapter", func(t *testing.T) {
+		events := make([]string, 0)
+		set := NewEnumSet[TestEnum]().Register(TestEnumA).SetMetrics(LookupMetricsFunc(func(event LookupEvent, key string) {
+			events = append(events, event.String()+":"+key)
+		}))
+		set.GetByName("ALPHA")
+		set.GetByValue(7)
+		assert.Equal(t, []string{"alias_hit:ALPHA", "value_miss:7"}, events)
+	})
+
+	t.Run("no metrics installed", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			NewEnumSet[TestEnum]().GetByName("A")
+		})
+	})
+}
@@ -0,0 +1,75 @@
+package goenum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// enumContextKey identifies an enum resolved by middleware in a request context
+type enumContextKey string
+
+// EnumParamError is the JSON body returned when a request parameter is not a valid enum
+type EnumParamError struct {
+	Error   string   `json:"error"`
+	Allowed []string `json:"allowed"`
+}
+
+// RequireEnumQuery returns middleware that resolves the query parameter against the set,
+// responding 400 with the allowed values if it is missing or unknown
+func RequireEnumQuery[T Enum](param string, set *EnumSet[T]) func(http.Handler) http.Handler {
+	return requireEnum("query parameter", param, set, func(r *http.Request) string {
+		return r.URL.Query().Get(param)
+	})
+}
+
+// RequireEnumHeader returns middleware that resolves the header against the set,
+// responding 400 with the allowed values if it is missing or unknown
+func RequireEnumHeader[T Enum](header string, set *EnumSet[T]) func(http.Handler) http.Handler {
+	return requireEnum("header", header, set, func(r *http.Request) string {
+		return r.Header.Get(header)
+	})
+}
+
+// RequireEnumPath returns middleware that resolves the http.ServeMux path wildcard against the set,
+// responding 400 with the allowed values if it is missing or unknown
+func RequireEnumPath[T Enum](wildcard string, set *EnumSet[T]) func(http.Handler) http.Handler {
+	return requireEnum("path parameter", wildcard, set, func(r *http.Request) string {
+		return r.PathValue(wildcard)
+	})
+}
+
+// EnumFromContext returns the enum resolved by middleware for the given parameter name
+func EnumFromContext[T Enum](ctx context.Context, param string) (T, bool) {
+	enum, ok := ctx.Value(enumContextKey(param)).(T)
+	return enum, ok
+}
+
+// requireEnum builds middleware resolving the value returned by extract against the set
+func requireEnum[T Enum](source, param string, set *EnumSet[T], extract func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw := extract(r)
+			if raw == "" {
+				writeEnumParamError(w, fmt.Sprintf("missing %s %s", source, param), set)
+				return
+			}
+			enum, exists := set.GetByName(raw)
+			if !exists {
+				writeEnumParamError(w, fmt.Sprintf("invalid %s %s: %q", source, param, raw), set)
+				return
+			}
+			ctx := context.WithValue(r.Context(), enumContextKey(param), enum)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// writeEnumParamError writes a 400 response listing the allowed enum names
+func writeEnumParamError[T Enum](w http.ResponseWriter, message string, set *EnumSet[T]) {
+	allowed := set.Names()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(EnumParamError{Error: message, Allowed: allowed})
+}
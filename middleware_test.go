@@ -0,0 +1,77 @@
+package goenum
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumMiddleware(t *testing.T) {
+	echo := func(param string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			enum, ok := EnumFromContext[TestEnum](r.Context(), param)
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(enum.String()))
+		})
+	}
+
+	t.Run("valid query parameter", func(t *testing.T) {
+		h := RequireEnumQuery("status", TestEnumSet)(echo("status"))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?status=beta", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "B", rec.Body.String(), "middleware should inject the resolved enum")
+	})
+
+	t.Run("invalid query parameter", func(t *testing.T) {
+		h := RequireEnumQuery("status", TestEnumSet)(echo("status"))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?status=nope", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var body EnumParamError
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, `invalid query parameter status: "nope"`, body.Error)
+		assert.Equal(t, []string{"A", "B", "C"}, body.Allowed, "error should list allowed values")
+	})
+
+	t.Run("missing query parameter", func(t *testing.T) {
+		h := RequireEnumQuery("status", TestEnumSet)(echo("status"))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		h := RequireEnumHeader("X-Status", TestEnumSet)(echo("X-Status"))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Status", "C")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, "C", rec.Body.String())
+	})
+
+	t.Run("path wildcard", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle("/items/{status}", RequireEnumPath("status", TestEnumSet)(echo("status")))
+
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/alpha", nil))
+		assert.Equal(t, "A", rec.Body.String())
+
+		rec = httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/zzz", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("missing context value", func(t *testing.T) {
+		_, ok := EnumFromContext[TestEnum](httptest.NewRequest(http.MethodGet, "/", nil).Context(), "status")
+		assert.False(t, ok, "EnumFromContext() should return false without middleware")
+	})
+}
@@ -0,0 +1,84 @@
+package goenum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// enumMigrations maps retired names and values to current enum names
+type enumMigrations struct {
+	names  map[string]string
+	values map[interface{}]string
+	// valueOrder holds the retired values in the order they were added
+	valueOrder []interface{}
+}
+
+// AddMigration maps a retired name (string) or value to the name of a current enum, so that
+// Parse, ParseJSON, strict unmarshaling, Null scanning and dynamic loading still accept
+// historical data, e.g. set.AddMigration("INACTIVE", "DELETED").
+// It returns the EnumSet for chaining and panics if current is not registered,
+// unless in panic-free mode.
+func (es *EnumSet[T]) AddMigration(old interface{}, current string) *EnumSet[T] {
+	enum, exists := es.values[strings.ToUpper(current)]
+	if !exists {
+		es.fail(fmt.Errorf("migration target %s is not registered", current))
+		return es
+	}
+	if es.migrations == nil {
+		es.migrations = &enumMigrations{
+			names:  make(map[string]string),
+			values: make(map[interface{}]string),
+		}
+	}
+	if name, ok := old.(string); ok {
+		es.migrations.names[strings.ToUpper(name)] = enum.String()
+	} else {
+		if _, exists := es.migrations.values[old]; !exists {
+			es.migrations.valueOrder = append(es.migrations.valueOrder, old)
+		}
+		es.migrations.values[old] = enum.String()
+	}
+	return es
+}
+
+// migrated returns the current name a retired name or value maps to
+func (es *EnumSet[T]) migrated(old interface{}) (string, bool) {
+	if es.migrations == nil {
+		return "", false
+	}
+	if name, ok := old.(string); ok {
+		current, exists := es.migrations.names[strings.ToUpper(name)]
+		return current, exists
+	}
+	current, exists := es.migrations.values[old]
+	return current, exists
+}
+
+// ownerMigrated implements enumOwner
+func (es *EnumSet[T]) ownerMigrated(old interface{}) (string, bool) {
+	return es.migrated(old)
+}
+
+// resolveName retrieves an enum by name or alias, then by migrated name
+func (es *EnumSet[T]) resolveName(name string) (T, bool) {
+	if enum, exists := es.GetByName(name); exists {
+		return enum, true
+	}
+	if current, exists := es.migrated(name); exists {
+		return es.GetByName(current)
+	}
+	var zero T
+	return zero, false
+}
+
+// resolveValue retrieves an enum by value, then by migrated value
+func (es *EnumSet[T]) resolveValue(value interface{}) (T, bool) {
+	if enum, exists := es.GetByValue(value); exists {
+		return enum, true
+	}
+	if current, exists := es.migrated(value); exists {
+		return es.GetByName(current)
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,107 @@
+package goenum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrations(t *testing.T) {
+	newSet := func() (*EnumSet[*EnumBase], *EnumBase, *EnumBase) {
+		active := NewEnumBase(1, "ACTIVE", "Active")
+		deleted := NewEnumBase(2, "DELETED", "Deleted")
+		set := NewEnumSet[*EnumBase]().Register(active).Register(deleted).
+			AddMigration("INACTIVE", "DELETED").
+			AddMigration(9, "DELETED")
+		return set, active, deleted
+	}
+
+	t.Run("AddMigration() target must exist", func(t *testing.T) {
+		set, _, _ := newSet()
+		assert.PanicsWithValue(t, "migration target ARCHIVED is not registered", func() {
+			set.AddMigration("OLD", "ARCHIVED")
+		})
+	})
+
+	t.Run("lookups are unaffected", func(t *testing.T) {
+		set, _, _ := newSet()
+		_, exists := set.GetByName("INACTIVE")
+		assert.False(t, exists, "GetByName() should not apply migrations")
+	})
+
+	t.Run("Parse()", func(t *testing.T) {
+		set, _, deleted := newSet()
+		enum, err := set.Parse("inactive")
+		assert.NoError(t, err)
+		assert.Same(t, deleted, enum, "Parse() should apply name migrations case-insensitively")
+
+		enum, err = set.Parse("9")
+		assert.NoError(t, err)
+		assert.Same(t, deleted, enum, "Parse() should apply value migrations")
+	})
+
+	t.Run("Parse() tries values in the order they were added", func(t *testing.T) {
+		set, active, _ := newSet()
+		set.AddMigration(int64(7), "ACTIVE").AddMigration(7, "DELETED").AddMigration(uint8(7), "DELETED")
+		for _, set := range []*EnumSet[*EnumBase]{set, set.clone()} {
+			for i := 0; i < 20; i++ {
+				enum, err := set.Parse("7")
+				assert.NoError(t, err)
+				assert.Same(t, active, enum, "values printing alike should resolve to the first migration added")
+			}
+		}
+	})
+
+	t.Run("ParseJSON()", func(t *testing.T) {
+		set, _, deleted := newSet()
+		enum, err := set.ParseJSON([]byte(`"INACTIVE"`))
+		assert.NoError(t, err)
+		assert.Same(t, deleted, enum, "ParseJSON() should apply name migrations")
+
+		set.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
+		enum, err = set.ParseJSON([]byte(`9`))
+		assert.NoError(t, err)
+		assert.Same(t, deleted, enum, "ParseJSON() should apply value migrations")
+	})
+
+	t.Run("strict unmarshal", func(t *testing.T) {
+		set, active, _ := newSet()
+		set.SetJSONConfig(&EnumJSONConfig{Strict: true})
+		decoded := active.Clone()
+		assert.NoError(t, decoded.UnmarshalJSON([]byte(`"INACTIVE"`)))
+		assert.Equal(t, "DELETED", decoded.String(), "strict unmarshal should apply name migrations")
+
+		set.SetJSONConfig(&EnumJSONConfig{Strict: true, Format: JSONFormatValue})
+		target := active.Clone()
+		assert.NoError(t, target.UnmarshalJSON([]byte(`9`)))
+		assert.Equal(t, "DELETED", target.String(), "strict unmarshal should apply value migrations")
+	})
+
+	t.Run("Null scanning", func(t *testing.T) {
+		set, _, deleted := newSet()
+		enum, err := set.scanValue([]byte("INACTIVE"))
+		assert.NoError(t, err)
+		assert.Same(t, deleted, enum)
+
+		enum, err = set.scanValue(int64(9))
+		assert.NoError(t, err)
+		assert.Same(t, deleted, enum, "scanning should apply value migrations to driver integers")
+	})
+
+	t.Run("dynamic loading", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip}).
+			AddMigration("INACTIVE", "DELETED")
+		err := loader.LoadFromReader(strings.NewReader(`[
+			{"name": "ACTIVE", "value": 1},
+			{"name": "INACTIVE", "value": 2, "description": "Retired name"}
+		]`))
+		assert.NoError(t, err)
+
+		set := loader.GetEnumSet()
+		assert.ElementsMatch(t, []string{"ACTIVE", "DELETED"}, set.Names(), "retired names should be loaded under their current name")
+		enum, err := set.Parse("INACTIVE")
+		assert.NoError(t, err)
+		assert.Equal(t, "DELETED", enum.String(), "the loaded set should keep the migration")
+	})
+}
@@ -0,0 +1,45 @@
+package goenum
+
+import "strings"
+
+// namespacedSet is implemented by enum sets that can carry a namespace
+type namespacedSet interface {
+	Namespace() string
+}
+
+// qualifySetName prefixes name with the namespace of set, if it has one
+func qualifySetName(set AnyEnumSet, name string) string {
+	if ns, ok := set.(namespacedSet); ok && ns.Namespace() != "" {
+		return ns.Namespace() + "." + name
+	}
+	return name
+}
+
+// SetNamespace sets the namespace of the set, e.g. "payments", and returns the EnumSet
+// for chaining. Namespaced sets are registered in the global registry under
+// "namespace.name", so catalogs of several services can share one registry.
+func (es *EnumSet[T]) SetNamespace(namespace string) *EnumSet[T] {
+	es.namespace = namespace
+	return es
+}
+
+// Namespace returns the namespace of the set, or an empty string if it has none
+func (es *EnumSet[T]) Namespace() string {
+	return es.namespace
+}
+
+// QualifiedName returns the name of an enum qualified with the set it belongs to,
+// e.g. "payments.Status/ACTIVE", as resolved by GetByQualifiedName
+func (es *EnumSet[T]) QualifiedName(name string) string {
+	return es.setName() + "/" + name
+}
+
+// GetByQualifiedName retrieves an enum from the global registry by its qualified name,
+// the set name and the enum name or alias separated by a slash: "payments.Status/ACTIVE"
+func GetByQualifiedName(qualified string) (Enum, bool) {
+	cut := strings.LastIndex(qualified, "/")
+	if cut < 0 {
+		return nil, false
+	}
+	return GetByName(qualified[:cut], qualified[cut+1:])
+}
@@ -0,0 +1,73 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespace(t *testing.T) {
+	payments := NewStringEnumSet("ACTIVE", "REFUNDED").SetNamespace("payments")
+	orders := NewStringEnumSet("ACTIVE", "SHIPPED").SetNamespace("orders")
+	RegisterSet("Status", payments)
+	RegisterSet("Status", orders)
+	t.Cleanup(func() {
+		UnregisterSet("payments.Status")
+		UnregisterSet("orders.Status")
+	})
+
+	t.Run("Namespace()", func(t *testing.T) {
+		assert.Equal(t, "payments", payments.Namespace())
+		assert.Equal(t, "", NewEnumSet[TestEnum]().Namespace())
+	})
+
+	t.Run("registered under the namespace", func(t *testing.T) {
+		set, exists := LookupSet("payments.Status")
+		assert.True(t, exists)
+		assert.Same(t, payments, set)
+		_, exists = LookupSet("Status")
+		assert.False(t, exists, "namespaced sets should not be registered under the bare name")
+	})
+
+	t.Run("GetByQualifiedName()", func(t *testing.T) {
+		enum, exists := GetByQualifiedName("payments.Status/REFUNDED")
+		assert.True(t, exists)
+		assert.Equal(t, "REFUNDED", enum.String())
+
+		enum, exists = GetByQualifiedName("orders.Status/ACTIVE")
+		assert.True(t, exists)
+		active, _ := orders.GetByName("ACTIVE")
+		assert.Same(t, active, enum)
+
+		_, exists = GetByQualifiedName("orders.Status/REFUNDED")
+		assert.False(t, exists)
+		_, exists = GetByQualifiedName("payments.Status")
+		assert.False(t, exists, "a qualified name needs an enum name")
+	})
+
+	t.Run("QualifiedName()", func(t *testing.T) {
+		assert.Equal(t, "payments.Status/ACTIVE", payments.QualifiedName("ACTIVE"))
+		unregistered := NewEnumSet[TestEnum]().SetNamespace("billing")
+		assert.Equal(t, "billing.TestEnum/A", unregistered.QualifiedName("A"))
+	})
+
+	t.Run("errors name the qualified set", func(t *testing.T) {
+		_, err := payments.GetByNameE("SHIPPED")
+		var unknown *UnknownEnumError
+		if assert.ErrorAs(t, err, &unknown) {
+			assert.Equal(t, "payments.Status", unknown.Set)
+		}
+	})
+}
+
+func TestNamespacedSetOf(t *testing.T) {
+	type NamespacedStatus struct{ *EnumBase }
+	set := NewEnumSet[NamespacedStatus]().SetNamespace("payments")
+	set.Register(NamespacedStatus{NewEnumBase(1, "ACTIVE", "Active")})
+	RegisterSetOf(set)
+	t.Cleanup(func() { UnregisterSet("payments.NamespacedStatus") })
+
+	var status Null[NamespacedStatus]
+	assert.NoError(t, status.Scan("ACTIVE"), "Null should resolve sets registered in a namespace")
+	assert.Equal(t, "ACTIVE", status.Enum.String())
+}
@@ -0,0 +1,76 @@
+package goenum
+
+import (
+	"strings"
+	"unicode"
+)
+
+// JSONNameTransform defines how enum names are cased in JSON output
+type JSONNameTransform int
+
+const (
+	// JSONNameAsIs keeps names exactly as registered (default)
+	JSONNameAsIs JSONNameTransform = iota
+	// JSONNameLower emits lowercase names, e.g. "in_progress"
+	JSONNameLower
+	// JSONNameCamel emits camelCase names, e.g. "inProgress"
+	JSONNameCamel
+	// JSONNameKebab emits kebab-case names, e.g. "in-progress"
+	JSONNameKebab
+)
+
+// apply converts a canonical name to the configured casing
+func (t JSONNameTransform) apply(name string) string {
+	switch t {
+	case JSONNameLower:
+		return strings.ToLower(name)
+	case JSONNameCamel:
+		words := nameWords(name)
+		for i, word := range words {
+			word = strings.ToLower(word)
+			if i > 0 && word != "" {
+				word = strings.ToUpper(word[:1]) + word[1:]
+			}
+			words[i] = word
+		}
+		return strings.Join(words, "")
+	case JSONNameKebab:
+		return strings.ToLower(strings.Join(nameWords(name), "-"))
+	default:
+		return name
+	}
+}
+
+// normalize converts a name in any casing back to the canonical SCREAMING_SNAKE_CASE form.
+// Names are left untouched when no transform is configured.
+func (t JSONNameTransform) normalize(name string) string {
+	if t == JSONNameAsIs {
+		return name
+	}
+	return strings.ToUpper(strings.Join(nameWords(name), "_"))
+}
+
+// nameWords splits a name on underscores, dashes, spaces and camelCase boundaries
+func nameWords(name string) []string {
+	words := make([]string, 0)
+	var current []rune
+	runes := []rune(name)
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = nil
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])):
+			flush()
+		}
+		current = append(current, r)
+	}
+	flush()
+	return words
+}
@@ -0,0 +1,58 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONNameTransform(t *testing.T) {
+	inProgress := NewEnumBase(1, "IN_PROGRESS", "Work in progress")
+
+	t.Run("marshal transforms", func(t *testing.T) {
+		for transform, expected := range map[JSONNameTransform]string{
+			JSONNameAsIs:  `"IN_PROGRESS"`,
+			JSONNameLower: `"in_progress"`,
+			JSONNameCamel: `"inProgress"`,
+			JSONNameKebab: `"in-progress"`,
+		} {
+			data, err := inProgress.MarshalJSONWithConfig(&EnumJSONConfig{NameTransform: transform})
+			assert.NoError(t, err)
+			assert.Equal(t, expected, string(data))
+		}
+	})
+
+	t.Run("full format transform", func(t *testing.T) {
+		data, err := inProgress.MarshalJSONWithConfig(&EnumJSONConfig{Format: JSONFormatFull, NameTransform: JSONNameKebab})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"in-progress","value":1,"description":"Work in progress"}`, string(data))
+	})
+
+	t.Run("unmarshal accepts any casing", func(t *testing.T) {
+		config := &EnumJSONConfig{NameTransform: JSONNameCamel}
+		for _, input := range []string{`"inProgress"`, `"in-progress"`, `"in_progress"`, `"IN_PROGRESS"`, `"InProgress"`} {
+			decoded := &EnumBase{}
+			assert.NoError(t, decoded.UnmarshalJSONWithConfig([]byte(input), config))
+			assert.Equal(t, "IN_PROGRESS", decoded.String(), "UnmarshalJSON() should normalize %s", input)
+		}
+
+		decoded := &EnumBase{}
+		assert.NoError(t, decoded.UnmarshalJSONWithConfig([]byte(`{"name":"in-progress","value":1}`), &EnumJSONConfig{Format: JSONFormatFull, NameTransform: JSONNameKebab}))
+		assert.Equal(t, "IN_PROGRESS", decoded.String(), "full format should normalize the name")
+	})
+
+	t.Run("as-is keeps input", func(t *testing.T) {
+		decoded := &EnumBase{}
+		assert.NoError(t, json.Unmarshal([]byte(`"inProgress"`), decoded))
+		assert.Equal(t, "inProgress", decoded.String(), "default configuration should not normalize names")
+	})
+
+	t.Run("set-level transform", func(t *testing.T) {
+		enum := TestEnum{NewEnumBase(1, "HTTP2_ONLY", "Digits in name")}
+		NewEnumSet[TestEnum]().Register(enum).SetJSONConfig(&EnumJSONConfig{NameTransform: JSONNameCamel})
+		data, err := json.Marshal(enum)
+		assert.NoError(t, err)
+		assert.Equal(t, `"http2Only"`, string(data))
+	})
+}
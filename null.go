@@ -0,0 +1,140 @@
+package goenum
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Null represents an enum that may be null. It implements sql.Scanner, driver.Valuer
+// and JSON marshaling, resolving values through the set registered for T with RegisterSetOf.
+type Null[T Enum] struct {
+	Enum  T
+	Valid bool // Valid is true if Enum is not NULL
+}
+
+// NewNull returns a valid Null holding enum
+func NewNull[T Enum](enum T) Null[T] {
+	return Null[T]{Enum: enum, Valid: true}
+}
+
+// Scan implements sql.Scanner, accepting the enum's value or name
+func (n *Null[T]) Scan(src interface{}) error {
+	if src == nil {
+		*n = Null[T]{}
+		return nil
+	}
+	set, err := registeredSetOf[T]()
+	if err != nil {
+		return err
+	}
+	enum, err := set.scanValue(src)
+	if err != nil {
+		return err
+	}
+	*n = NewNull(enum)
+	return nil
+}
+
+// Value implements driver.Valuer, storing the enum's value
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.Enum.Value())
+}
+
+// MarshalJSON implements json.Marshaler, encoding an invalid Null as null
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Enum)
+}
+
+// UnmarshalJSON implements json.Unmarshaler; null produces an invalid Null
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = Null[T]{}
+		return nil
+	}
+	set, err := registeredSetOf[T]()
+	if err != nil {
+		return err
+	}
+	enum, err := set.ParseJSON(data)
+	if err != nil {
+		return err
+	}
+	*n = NewNull(enum)
+	return nil
+}
+
+// registeredSetOf returns the set registered for T with RegisterSetOf, preferring no
+// namespace, then the only namespaced set; sets of T in several namespaces are ambiguous
+func registeredSetOf[T Enum]() (*EnumSet[T], error) {
+	if set, exists := LookupSetOf[T](typeName[T]()); exists {
+		return set, nil
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	var found *EnumSet[T]
+	var candidates []string
+	for name, set := range registry.sets {
+		typed, ok := set.(*EnumSet[T])
+		if ok && name == qualifySetName(typed, typeName[T]()) {
+			found = typed
+			candidates = append(candidates, name)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no enum set registered for type %s", typeName[T]())
+	case 1:
+		return found, nil
+	}
+	sort.Strings(candidates)
+	return nil, fmt.Errorf("ambiguous enum sets registered for type %s: %s", typeName[T](), strings.Join(candidates, ", "))
+}
+
+// scanValue resolves a database value to a registered enum by value, then by name,
+// then by migrated name or value
+func (es *EnumSet[T]) scanValue(src interface{}) (T, error) {
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+	candidates := []interface{}{src}
+	// Drivers return integers as int64 and floats as float64
+	switch v := src.(type) {
+	case int64:
+		candidates = append(candidates, int(v))
+	case float64:
+		// Fractional values only match float enum values, never the truncated int
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			candidates = append(candidates, int(v))
+		}
+	}
+	for _, candidate := range candidates {
+		if _, exists := es.byValue[candidate]; exists {
+			enum, _ := es.GetByValue(candidate)
+			return enum, nil
+		}
+	}
+	if name, ok := src.(string); ok {
+		if enum, exists := es.GetByName(name); exists {
+			return enum, nil
+		}
+	}
+	for _, candidate := range candidates {
+		if current, exists := es.migrated(candidate); exists {
+			enum, _ := es.GetByName(current)
+			return enum, nil
+		}
+	}
+	var zero T
+	return zero, newUnknownEnumError(fmt.Sprint(src), es)
+}
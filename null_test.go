@@ -0,0 +1,92 @@
+package goenum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNull(t *testing.T) {
+	RegisterSetOf(TestEnumSet)
+	t.Cleanup(func() { UnregisterSet("TestEnum") })
+
+	t.Run("Scan() values and names", func(t *testing.T) {
+		var n Null[TestEnum]
+		assert.NoError(t, n.Scan(int64(2)))
+		assert.Equal(t, NewNull(TestEnumB), n, "Scan() should resolve driver int64 values")
+
+		assert.NoError(t, n.Scan([]byte("C")))
+		assert.Equal(t, NewNull(TestEnumC), n, "Scan() should resolve names from bytes")
+
+		assert.NoError(t, n.Scan("alpha"))
+		assert.Equal(t, NewNull(TestEnumA), n, "Scan() should resolve aliases")
+
+		assert.NoError(t, n.Scan(nil))
+		assert.False(t, n.Valid, "Scan(nil) should produce an invalid Null")
+
+		err := n.Scan(int64(9))
+		assert.ErrorIs(t, err, ErrUnknownEnum, "Scan() should reject unknown values")
+
+		assert.NoError(t, n.Scan(float64(3)))
+		assert.Equal(t, NewNull(TestEnumC), n, "Scan() should resolve whole float64 values")
+
+		n = NewNull(TestEnumA)
+		err = n.Scan(float64(1.7))
+		assert.ErrorIs(t, err, ErrUnknownEnum, "Scan() should not truncate fractional values")
+		assert.Equal(t, NewNull(TestEnumA), n, "a failed Scan() should leave the Null unchanged")
+	})
+
+	t.Run("Value()", func(t *testing.T) {
+		value, err := NewNull(TestEnumB).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, driver.Value(int64(2)), value, "Value() should store the enum value")
+
+		value, err = Null[TestEnum]{}.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, value, "Value() of an invalid Null should be nil")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type order struct {
+			Status   Null[TestEnum] `json:"status"`
+			Previous Null[TestEnum] `json:"previous"`
+		}
+		data, err := json.Marshal(order{Status: NewNull(TestEnumA)})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"status": "A", "previous": null}`, string(data))
+
+		var decoded order
+		assert.NoError(t, json.Unmarshal([]byte(`{"status": "BETA", "previous": null}`), &decoded))
+		assert.Equal(t, NewNull(TestEnumB), decoded.Status, "UnmarshalJSON() should resolve registered enums")
+		assert.False(t, decoded.Previous.Valid, "null should produce an invalid Null")
+
+		decoded = order{}
+		assert.NoError(t, json.Unmarshal([]byte(`{}`), &decoded))
+		assert.False(t, decoded.Status.Valid, "omitted fields should stay invalid")
+
+		assert.Error(t, json.Unmarshal([]byte(`{"status": "Z"}`), &decoded))
+	})
+
+	t.Run("unregistered type", func(t *testing.T) {
+		var n Null[*EnumBase]
+		assert.EqualError(t, n.Scan(int64(1)), "no enum set registered for type *goenum.EnumBase")
+	})
+
+	t.Run("namespaced sets", func(t *testing.T) {
+		billing := NewEnumSet[*EnumBase]().SetNamespace("billing").Register(NewEnumBase(1, "PAID", ""))
+		RegisterSetOf(billing)
+		t.Cleanup(func() { UnregisterSet("billing.*goenum.EnumBase") })
+
+		var n Null[*EnumBase]
+		assert.NoError(t, n.Scan(int64(1)), "a single namespaced set should be used")
+		assert.Equal(t, "PAID", n.Enum.String())
+
+		shipping := NewEnumSet[*EnumBase]().SetNamespace("shipping").Register(NewEnumBase(1, "SHIPPED", ""))
+		RegisterSetOf(shipping)
+		t.Cleanup(func() { UnregisterSet("shipping.*goenum.EnumBase") })
+		assert.EqualError(t, n.Scan(int64(1)),
+			"ambiguous enum sets registered for type *goenum.EnumBase: billing.*goenum.EnumBase, shipping.*goenum.EnumBase")
+	})
+}
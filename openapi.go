@@ -0,0 +1,143 @@
+package goenum
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// OpenAPISchema is the OpenAPI (Swagger) schema of an enum-typed field. It marshals to the
+// JSON of a spec.Schema, so it can be decoded into the schema type of an OpenAPI library.
+type OpenAPISchema struct {
+	Type        string        `json:"type"`
+	Enum        []interface{} `json:"enum"`
+	Default     interface{}   `json:"default,omitempty"`
+	Description string        `json:"description,omitempty"`
+	// EnumVarNames names the values, as x-enum-varnames, when they are not the names
+	EnumVarNames []string `json:"x-enum-varnames,omitempty"`
+}
+
+// OpenAPISchema returns the schema of fields holding enums of the set. Its values are the
+// JSON encodings of the registered enums in registration order, so the schema follows the
+// JSON configuration of the set, and its default is the default of the set, if any.
+func (es *EnumSet[T]) OpenAPISchema() (OpenAPISchema, error) {
+	schema := OpenAPISchema{Enum: make([]interface{}, 0, len(es.values))}
+	names := make([]string, 0, len(es.values))
+	namesDiffer := false
+	for _, enum := range es.Values() {
+		value, err := wireValue(enum)
+		if err != nil {
+			return OpenAPISchema{}, err
+		}
+		schema.Enum = append(schema.Enum, value)
+		names = append(names, enum.String())
+		if value != enum.String() {
+			namesDiffer = true
+		}
+		schema.Type = mergeSchemaType(schema.Type, openAPIType(value))
+	}
+	if schema.Type == "" {
+		schema.Type = "string"
+	}
+	if namesDiffer && schema.Type != "object" {
+		schema.EnumVarNames = names
+	}
+	if enum, exists := es.Default(); exists {
+		value, err := wireValue(enum)
+		if err != nil {
+			return OpenAPISchema{}, err
+		}
+		schema.Default = value
+	}
+	return schema, nil
+}
+
+// SwaggoEnums returns the JSON values of the set joined by commas, for the enums struct tag
+// of swaggo: `enums:"ACTIVE,CLOSED"`
+func (es *EnumSet[T]) SwaggoEnums() (string, error) {
+	values, err := es.swaggoValues()
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(values, ","), nil
+}
+
+// SwaggoParam returns a swaggo @Param annotation for a parameter holding enums of the set,
+// in "query", "path", "header" or "formData", e.g.
+//
+//	@Param status query string true "Order status" Enums(ACTIVE, CLOSED) default(ACTIVE)
+func (es *EnumSet[T]) SwaggoParam(name, in, description string, required bool) (string, error) {
+	schema, err := es.OpenAPISchema()
+	if err != nil {
+		return "", err
+	}
+	values, err := es.swaggoValues()
+	if err != nil {
+		return "", err
+	}
+	param := fmt.Sprintf("@Param %s %s %s %t %q Enums(%s)", name, in, schema.Type, required, description, strings.Join(values, ", "))
+	if schema.Default != nil {
+		param += fmt.Sprintf(" default(%v)", schema.Default)
+	}
+	return param, nil
+}
+
+// swaggoValues returns the JSON values of the set formatted for swaggo annotations
+func (es *EnumSet[T]) swaggoValues() ([]string, error) {
+	values := make([]string, 0, len(es.values))
+	for _, enum := range es.Values() {
+		value, err := wireValue(enum)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := value.(map[string]interface{}); ok {
+			return nil, fmt.Errorf("enum %s is encoded as an object, which swaggo cannot enumerate", enum.String())
+		}
+		values = append(values, fmt.Sprint(value))
+	}
+	return values, nil
+}
+
+// wireValue returns the JSON encoding of enum decoded into a plain Go value
+func wireValue(enum Enum) (interface{}, error) {
+	data, err := json.Marshal(enum)
+	if err != nil {
+		return nil, fmt.Errorf("enum %s: %w", enum.String(), err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("enum %s: %w", enum.String(), err)
+	}
+	return value, nil
+}
+
+// openAPIType returns the OpenAPI type of a decoded JSON value
+func openAPIType(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+		return "number"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	return ""
+}
+
+// mergeSchemaType combines the types of two values, widening integer and number to number
+func mergeSchemaType(a, b string) string {
+	switch {
+	case a == "" || a == b:
+		return b
+	case (a == "integer" || a == "number") && (b == "integer" || b == "number"):
+		return "number"
+	}
+	return "string"
+}
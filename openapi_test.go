@@ -0,0 +1,48 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAPI(t *testing.T) {
+	newSet := func(config *EnumJSONConfig) *EnumSet[*EnumBase] {
+		set := NewEnumSet[*EnumBase]().SetJSONConfig(config)
+		active := NewEnumBase(1, "ACTIVE", "Active")
+		set.Register(active)
+		set.Register(NewEnumBase(2, "CLOSED", "Closed"))
+		set.SetDefault(active)
+		return set
+	}
+	byName := newSet(nil)
+	byValue := newSet(&EnumJSONConfig{Format: JSONFormatValue})
+
+	t.Run("OpenAPISchema()", func(t *testing.T) {
+		schema, err := byName.OpenAPISchema()
+		assert.NoError(t, err)
+		data, _ := json.Marshal(schema)
+		assert.JSONEq(t, `{"type": "string", "enum": ["ACTIVE", "CLOSED"], "default": "ACTIVE"}`, string(data))
+
+		schema, err = byValue.OpenAPISchema()
+		assert.NoError(t, err)
+		data, _ = json.Marshal(schema)
+		assert.JSONEq(t, `{"type": "integer", "enum": [1, 2], "default": 1, "x-enum-varnames": ["ACTIVE", "CLOSED"]}`, string(data))
+	})
+
+	t.Run("SwaggoEnums()", func(t *testing.T) {
+		enums, err := byName.SwaggoEnums()
+		assert.NoError(t, err)
+		assert.Equal(t, "ACTIVE,CLOSED", enums)
+
+		_, err = newSet(&EnumJSONConfig{Format: JSONFormatFull}).SwaggoEnums()
+		assert.Error(t, err, "objects cannot be enumerated")
+	})
+
+	t.Run("SwaggoParam()", func(t *testing.T) {
+		param, err := byValue.SwaggoParam("status", "query", "Order status", true)
+		assert.NoError(t, err)
+		assert.Equal(t, `@Param status query integer true "Order status" Enums(1, 2) default(1)`, param)
+	})
+}
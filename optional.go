@@ -0,0 +1,45 @@
+package goenum
+
+// Optional holds an enum that may be absent, making "might not be registered" explicit
+type Optional[T Enum] struct {
+	enum    T
+	present bool
+}
+
+// Some returns an Optional holding enum
+func Some[T Enum](enum T) Optional[T] {
+	return Optional[T]{enum: enum, present: true}
+}
+
+// None returns an empty Optional
+func None[T Enum]() Optional[T] {
+	return Optional[T]{}
+}
+
+// OptionalOf wraps the result of a lookup:
+//
+//	status := goenum.OptionalOf(Statuses.GetByName(name)).OrElse(StatusPending)
+func OptionalOf[T Enum](enum T, exists bool) Optional[T] {
+	if !exists {
+		return None[T]()
+	}
+	return Some(enum)
+}
+
+// IsPresent reports whether the Optional holds an enum
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get returns the enum and whether it is present
+func (o Optional[T]) Get() (T, bool) {
+	return o.enum, o.present
+}
+
+// OrElse returns the enum if present, or def otherwise
+func (o Optional[T]) OrElse(def T) T {
+	if !o.present {
+		return def
+	}
+	return o.enum
+}
@@ -0,0 +1,33 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptional(t *testing.T) {
+	t.Run("Some()", func(t *testing.T) {
+		o := Some(TestEnumA)
+		assert.True(t, o.IsPresent(), "Some() should be present")
+		enum, ok := o.Get()
+		assert.True(t, ok)
+		assert.Equal(t, TestEnumA, enum, "Get() should return the enum")
+		assert.Equal(t, TestEnumA, o.OrElse(TestEnumB), "OrElse() should return the present enum")
+	})
+
+	t.Run("None()", func(t *testing.T) {
+		o := None[TestEnum]()
+		assert.False(t, o.IsPresent(), "None() should be absent")
+		enum, ok := o.Get()
+		assert.False(t, ok)
+		assert.Nil(t, enum.EnumBase, "Get() should return the zero enum")
+		assert.Equal(t, TestEnumB, o.OrElse(TestEnumB), "OrElse() should return the default")
+	})
+
+	t.Run("OptionalOf() lookups", func(t *testing.T) {
+		assert.Equal(t, Some(TestEnumB), OptionalOf(TestEnumSet.GetByName("BETA")), "OptionalOf() should wrap hits")
+		assert.Equal(t, None[TestEnum](), OptionalOf(TestEnumSet.GetByValue(42)), "OptionalOf() should wrap misses")
+		assert.Equal(t, TestEnumC, OptionalOf(TestEnumSet.GetByName("MISSING")).OrElse(TestEnumC))
+	})
+}
@@ -0,0 +1,89 @@
+package goenum
+
+import "fmt"
+
+// EnumOption is an enum as a label/value pair for select inputs and UI pickers
+type EnumOption struct {
+	Label       string `json:"label"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+	// Disabled marks deprecated enums, which existing records may still hold
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// OptionsOption configures the options returned by Options
+type OptionsOption func(*optionsConfig)
+
+// optionsConfig holds the settings applied by OptionsOption functions
+type optionsConfig struct {
+	groups         map[string]bool
+	hideDeprecated bool
+	language       string
+	localize       func(enum Enum, language string) (label, description string)
+}
+
+// OptionsInGroups only includes enums belonging to one of the groups
+func OptionsInGroups(groups ...string) OptionsOption {
+	return func(c *optionsConfig) {
+		if c.groups == nil {
+			c.groups = make(map[string]bool, len(groups))
+		}
+		for _, group := range groups {
+			c.groups[group] = true
+		}
+	}
+}
+
+// OptionsHideDeprecated leaves out deprecated enums instead of disabling them
+func OptionsHideDeprecated() OptionsOption {
+	return func(c *optionsConfig) {
+		c.hideDeprecated = true
+	}
+}
+
+// OptionsLocalized translates labels and descriptions to language with localize,
+// which returns an empty string for anything it has no translation of
+func OptionsLocalized(language string, localize func(enum Enum, language string) (label, description string)) OptionsOption {
+	return func(c *optionsConfig) {
+		c.language = language
+		c.localize = localize
+	}
+}
+
+// Options returns the enums of the set as label/value pairs in registration order, labeled
+// with their display names and valued with their formatted values
+func (es *EnumSet[T]) Options(opts ...OptionsOption) []EnumOption {
+	config := &optionsConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	options := make([]EnumOption, 0, len(es.values))
+	for _, enum := range es.Values() {
+		if config.groups != nil && !config.groups[groupOf(enum)] {
+			continue
+		}
+		deprecated := isDeprecated(enum)
+		if deprecated && config.hideDeprecated {
+			continue
+		}
+
+		option := EnumOption{
+			Label:       labelOf(enum),
+			Value:       fmt.Sprint(enum.Value()),
+			Description: enum.Description(),
+			Disabled:    deprecated,
+		}
+		if config.localize != nil {
+			label, description := config.localize(enum, config.language)
+			if label != "" {
+				option.Label = label
+			}
+			if description != "" {
+				option.Description = description
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
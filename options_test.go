@@ -0,0 +1,57 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	set := NewEnumSet[*EnumBase]().
+		Register(NewEnumBase(1, "ACTIVE", "Active account").WithGroup("open")).
+		Register(NewEnumBase(2, "PENDING", "Awaiting review").WithGroup("open")).
+		Register(NewEnumBase(3, "CLOSED", "Closed account").WithGroup("closed")).
+		Register(NewEnumBase(4, "LEGACY", "Old status").WithGroup("closed").Deprecate())
+
+	t.Run("defaults", func(t *testing.T) {
+		assert.Equal(t, []EnumOption{
+			{Label: "ACTIVE", Value: "1", Description: "Active account"},
+			{Label: "PENDING", Value: "2", Description: "Awaiting review"},
+			{Label: "CLOSED", Value: "3", Description: "Closed account"},
+			{Label: "LEGACY", Value: "4", Description: "Old status", Disabled: true},
+		}, set.Options())
+	})
+
+	t.Run("groups and deprecation", func(t *testing.T) {
+		options := set.Options(OptionsInGroups("closed"), OptionsHideDeprecated())
+		assert.Equal(t, []EnumOption{{Label: "CLOSED", Value: "3", Description: "Closed account"}}, options)
+		assert.Len(t, set.Options(OptionsInGroups("open", "closed")), 4)
+		assert.Empty(t, set.Options(OptionsInGroups("missing")))
+	})
+
+	t.Run("localization", func(t *testing.T) {
+		localize := func(enum Enum, language string) (string, string) {
+			if language == "de" && enum.String() == "ACTIVE" {
+				return "Aktiv", "Aktives Konto"
+			}
+			if language == "de" && enum.String() == "PENDING" {
+				return "Ausstehend", ""
+			}
+			return "", ""
+		}
+		options := set.Options(OptionsInGroups("open"), OptionsLocalized("de", localize))
+		assert.Equal(t, []EnumOption{
+			{Label: "Aktiv", Value: "1", Description: "Aktives Konto"},
+			{Label: "Ausstehend", Value: "2", Description: "Awaiting review"},
+		}, options)
+	})
+
+	t.Run("Deprecate()", func(t *testing.T) {
+		enum, _ := set.GetByName("LEGACY")
+		assert.True(t, enum.IsDeprecated())
+		assert.False(t, NewEnumBase(5, "NEW", "").IsDeprecated())
+		var nilEnum *EnumBase
+		assert.False(t, nilEnum.IsDeprecated())
+		assert.Nil(t, nilEnum.Deprecate())
+	})
+}
@@ -0,0 +1,56 @@
+package goenum
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// panicFreeHandler receives the errors that would panic in package-wide panic-free mode
+var panicFreeHandler atomic.Pointer[func(error)]
+
+// SetPanicFree turns the package-wide panic-free mode on with a non-nil handler, or off
+// with nil. In panic-free mode, functions and chainable methods that panic on invalid
+// input (Register, SetDefault, SetUnknown, AddIndex, AddMigration, RegisterSet,
+// RegisterLoaderFormat) pass the error to handler and return without effect instead;
+// EnumSet methods also record it for Err. Use it for services that must never panic
+// from library code during runtime catalog updates.
+func SetPanicFree(handler func(err error)) {
+	if handler == nil {
+		panicFreeHandler.Store(nil)
+		return
+	}
+	panicFreeHandler.Store(&handler)
+}
+
+// SetPanicFree makes the chainable methods of this set record failures for Err instead
+// of panicking, regardless of the package-wide mode, and returns the EnumSet for chaining
+func (es *EnumSet[T]) SetPanicFree(enabled bool) *EnumSet[T] {
+	es.panicFree = enabled
+	return es
+}
+
+// Err returns the failures recorded in panic-free mode, joined, or nil if there were none
+func (es *EnumSet[T]) Err() error {
+	return errors.Join(es.errs...)
+}
+
+// fail panics with err, or records it for Err in panic-free mode
+func (es *EnumSet[T]) fail(err error) {
+	handler := panicFreeHandler.Load()
+	if handler == nil && !es.panicFree {
+		panic(err.Error())
+	}
+	es.errs = append(es.errs, err)
+	if handler != nil {
+		(*handler)(err)
+	}
+}
+
+// fail panics with err, or passes it to the handler in package-wide panic-free mode
+func fail(err error) {
+	handler := panicFreeHandler.Load()
+	if handler == nil {
+		panic(err.Error())
+	}
+	(*handler)(err)
+}
@@ -0,0 +1,65 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanicFreeMode(t *testing.T) {
+	t.Run("per set", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().SetPanicFree(true)
+		assert.NotPanics(t, func() {
+			set.Register(TestEnumA).
+				Register(TestEnum{NewEnumBase(1, "DUPLICATE", "Duplicate value")}).
+				SetDefault(TestEnumB).
+				SetUnknown(TestEnumC).
+				AddMigration("OLD", "MISSING").
+				AddIndex("description", func(e TestEnum) interface{} { return e.Description() }).
+				AddIndex("description", func(e TestEnum) interface{} { return e.Description() })
+		})
+
+		assert.True(t, set.Contains(TestEnumA), "valid calls should still take effect")
+		_, exists := set.GetByIndex("description", "First enum")
+		assert.True(t, exists)
+		_, hasDefault := set.Default()
+		assert.False(t, hasDefault, "failed calls should have no effect")
+
+		err := set.Err()
+		assert.ErrorContains(t, err, "duplicate enum value: 1")
+		assert.ErrorContains(t, err, "default enum B is not registered")
+		assert.ErrorContains(t, err, "unknown sentinel C is not registered")
+		assert.ErrorContains(t, err, "migration target MISSING is not registered")
+		assert.ErrorContains(t, err, "duplicate enum index: description")
+
+		assert.NoError(t, NewEnumSet[TestEnum]().SetPanicFree(true).Register(TestEnumA).Err())
+		assert.Panics(t, func() {
+			NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumA)
+		}, "sets should panic outside panic-free mode")
+	})
+
+	t.Run("package-wide", func(t *testing.T) {
+		var handled []error
+		SetPanicFree(func(err error) { handled = append(handled, err) })
+		t.Cleanup(func() { SetPanicFree(nil) })
+
+		set := NewEnumSet[TestEnum]()
+		assert.NotPanics(t, func() {
+			set.Register(TestEnumA).Register(TestEnumA)
+			RegisterSet("", set)
+			RegisterLoaderFormat(".json", propertiesCodec)
+		})
+		assert.Len(t, handled, 3)
+		assert.EqualError(t, set.Err(), "duplicate enum name: A")
+		assert.EqualError(t, handled[1], "enum set name cannot be empty")
+		assert.EqualError(t, handled[2], "the .json loader format is built in")
+
+		SetPanicFree(nil)
+		assert.Panics(t, func() { RegisterSet("", set) })
+	})
+
+	t.Run("Try variants", func(t *testing.T) {
+		assert.EqualError(t, TryRegisterSet("", TestEnumSet), "enum set name cannot be empty")
+		assert.EqualError(t, TryRegisterLoaderFormat("", propertiesCodec), "loader format extension cannot be empty")
+	})
+}
@@ -0,0 +1,141 @@
+package goenum
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefinitionError reports a definition the loader rejected, with its location in the source
+type DefinitionError struct {
+	// File is the source file, empty for readers, slices and maps
+	File string
+	// Index is the position of the definition in its array, or -1 when unknown
+	Index int
+	// Name is the definition name, empty when it could not be decoded
+	Name string
+	// Offset is the byte offset of the definition in the source, or -1 when unknown
+	Offset int64
+	// Line and Column locate the definition in the source; zero when unknown
+	Line   int
+	Column int
+	// Err is the underlying error
+	Err error
+}
+
+// Error implements the error interface, e.g.
+// "statuses.json: line 58, column 3: definition #14 (name=ACTIVE): duplicate enum found: ..."
+func (e *DefinitionError) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		b.WriteString(e.File)
+		b.WriteString(": ")
+	}
+	if e.Line > 0 {
+		fmt.Fprintf(&b, "line %d, column %d: ", e.Line, e.Column)
+	}
+	b.WriteString("definition")
+	if e.Index >= 0 {
+		fmt.Fprintf(&b, " #%d", e.Index)
+	}
+	if e.Name != "" {
+		fmt.Fprintf(&b, " (name=%s)", e.Name)
+	}
+	fmt.Fprintf(&b, ": %v", e.Err)
+	return b.String()
+}
+
+// Unwrap returns the underlying error
+func (e *DefinitionError) Unwrap() error {
+	return e.Err
+}
+
+// definitionErrorAt wraps err with the position of the definition at index in a slice
+func definitionErrorAt(index int, name string, err error) *DefinitionError {
+	return &DefinitionError{Index: index, Name: name, Offset: -1, Err: err}
+}
+
+// withFile records filename on the DefinitionError in err, if any
+func withFile(err error, filename string) error {
+	if definitionErr, ok := err.(*DefinitionError); ok && definitionErr.File == "" {
+		definitionErr.File = filename
+	}
+	return err
+}
+
+// positionReader tracks newlines passing through a reader so that increasing byte
+// offsets can be turned into lines and columns without keeping the whole input
+type positionReader struct {
+	reader    io.Reader
+	read      int64
+	newlines  []int64 // offsets of newlines not yet passed by position
+	line      int
+	lineStart int64
+}
+
+// newPositionReader returns a positionReader reading from reader
+func newPositionReader(reader io.Reader) *positionReader {
+	return &positionReader{reader: reader, line: 1}
+}
+
+// Read implements io.Reader
+func (r *positionReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	for i, b := range p[:n] {
+		if b == '\n' {
+			r.newlines = append(r.newlines, r.read+int64(i))
+		}
+	}
+	r.read += int64(n)
+	return n, err
+}
+
+// position returns the line and column of offset, which must not be smaller than
+// the offset of any earlier call
+func (r *positionReader) position(offset int64) (line, column int) {
+	for len(r.newlines) > 0 && r.newlines[0] < offset {
+		r.line++
+		r.lineStart = r.newlines[0] + 1
+		r.newlines = r.newlines[1:]
+	}
+	return r.line, int(offset-r.lineStart) + 1
+}
+
+// decodeDefinitions decodes a JSON array of definitions one at a time, passing each
+// to fn with a DefinitionError template locating it. Decoding stops at the first error.
+func decodeDefinitions(reader io.Reader, fn func(def EnumDefinition, position *DefinitionError) error) error {
+	source := newPositionReader(reader)
+	decoder := json.NewDecoder(source)
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("failed to decode JSON: expected array, got %v", token)
+	}
+
+	for index := 0; decoder.More(); index++ {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return fmt.Errorf("failed to decode JSON: %w", err)
+		}
+		offset := decoder.InputOffset() - int64(len(raw))
+		line, column := source.position(offset)
+		position := &DefinitionError{Index: index, Offset: offset, Line: line, Column: column}
+
+		var def EnumDefinition
+		if err := json.Unmarshal(raw, &def); err != nil {
+			position.Err = fmt.Errorf("failed to decode JSON: %w", err)
+			return position
+		}
+		position.Name = def.Name
+		if err := fn(def, position); err != nil {
+			return err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package goenum
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefinitionError(t *testing.T) {
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+	const document = `[
+  {"name": "PENDING", "value": 0},
+  {"name": "ACTIVE", "value": 1},
+    {"name": "", "value": 2}
+]`
+
+	t.Run("LoadFromReader", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromReader(strings.NewReader(document))
+		assert.EqualError(t, err, "line 4, column 5: definition #2: invalid enum definition: enum name cannot be empty")
+
+		var definitionErr *DefinitionError
+		assert.True(t, errors.As(err, &definitionErr))
+		assert.Equal(t, 2, definitionErr.Index)
+		assert.Equal(t, int64(strings.Index(document, `{"name": ""`)), definitionErr.Offset)
+	})
+
+	t.Run("LoadFromStream", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromStream(strings.NewReader(document))
+		var definitionErr *DefinitionError
+		assert.True(t, errors.As(err, &definitionErr))
+		assert.Equal(t, 4, definitionErr.Line)
+		assert.Equal(t, 5, definitionErr.Column)
+	})
+
+	t.Run("LoadFromJSON names the file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "statuses.json")
+		assert.NoError(t, os.WriteFile(file, []byte(`[
+  {"name": "ACTIVE", "value": 1},
+  {"name": "ACTIVE", "value": 2}
+]`), 0644))
+		loader := NewDynamicEnumLoader(DefaultValidationOptions())
+		err := loader.LoadFromJSON(file)
+		assert.EqualError(t, err, file+": line 3, column 3: definition #1 (name=ACTIVE): duplicate enum found: name=ACTIVE, value=2")
+
+		err = NewDynamicEnumLoader(DefaultValidationOptions()).LoadFromDirectory(filepath.Dir(file))
+		assert.True(t, strings.HasPrefix(err.Error(), file+": line 3"), "directory loading should not repeat the file name")
+	})
+
+	t.Run("type errors", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromReader(strings.NewReader(`[{"name": "A", "value": 1}, {"name": 2, "value": 2}]`))
+		assert.ErrorContains(t, err, "line 1, column 29: definition #1: failed to decode JSON")
+	})
+
+	t.Run("JSONC keeps positions", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip, JSONC: true})
+		err := loader.LoadFromReader(strings.NewReader("[\n  // first\n  {\"name\": \"\", \"value\": 1},\n]"))
+		assert.ErrorContains(t, err, "line 3, column 3: definition #0")
+	})
+
+	t.Run("LoadFromSlice", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(options)
+		err := loader.LoadFromSlice([]EnumDefinition{{Name: "A", Value: 1}, {Name: "B"}})
+		assert.EqualError(t, err, "definition #1 (name=B): invalid enum definition: enum value cannot be nil")
+	})
+}
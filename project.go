@@ -0,0 +1,19 @@
+package goenum
+
+// Project builds a new set from the definitions fn derives from every enum, e.g. the same
+// names with a partner's values or without descriptions, for export to a specific system.
+// Enums are projected in registration order and the definitions are validated like LoadFromSlice
+// with the default validation options; a rejected definition is returned as *DefinitionError.
+func (es *EnumSet[T]) Project(fn func(T) EnumDefinition) (*EnumSet[Enum], error) {
+	enums := es.Values()
+	definitions := make([]EnumDefinition, len(enums))
+	for i, enum := range enums {
+		definitions[i] = fn(enum)
+	}
+
+	loader := NewDynamicEnumLoader(nil)
+	if err := loader.LoadFromSlice(definitions); err != nil {
+		return nil, err
+	}
+	return loader.GetEnumSet(), nil
+}
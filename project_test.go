@@ -0,0 +1,37 @@
+package goenum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProject(t *testing.T) {
+	t.Run("transformed values", func(t *testing.T) {
+		projected, err := TestEnumSet.Project(func(e TestEnum) EnumDefinition {
+			def := definitionOf(e)
+			def.Value = strings.ToLower(e.String())
+			def.Description = ""
+			return def
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"A", "B", "C"}, projected.Names())
+
+		enum, exists := projected.GetByValue("c")
+		assert.True(t, exists)
+		assert.Equal(t, "C", enum.String())
+		assert.Empty(t, enum.Description())
+		assert.Equal(t, []string{"CHARLIE", "THIRD"}, enum.Aliases())
+
+		original, _ := TestEnumSet.GetByName("C")
+		assert.Equal(t, 3, original.Value(), "Project() should not change the source set")
+	})
+
+	t.Run("invalid definitions", func(t *testing.T) {
+		_, err := TestEnumSet.Project(func(e TestEnum) EnumDefinition {
+			return EnumDefinition{Name: e.String(), Value: 1}
+		})
+		assert.EqualError(t, err, "definition #1 (name=B): duplicate enum found: name=B, value=1")
+	})
+}
@@ -0,0 +1,119 @@
+package goenum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProtoEnum is satisfied by protoc-generated enum types, which are int32 types whose
+// String method returns the name of the value, e.g. pb.Status with STATUS_ACTIVE. It keeps
+// FromProto and ToProto free of a protobuf dependency.
+type ProtoEnum interface {
+	~int32
+	String() string
+}
+
+// ProtoMatch selects how FromProto and ToProto pair proto values with enums
+type ProtoMatch int
+
+const (
+	// ProtoByNumber pairs the proto number with the integer value of the enum (default)
+	ProtoByNumber ProtoMatch = iota
+	// ProtoByName pairs the proto value name, without Prefix, with the enum name or alias
+	ProtoByName
+)
+
+// ProtoOptions configures FromProto and ToProto
+type ProtoOptions struct {
+	// Match selects pairing by number or by name
+	Match ProtoMatch
+	// Prefix is the prefix protoc style puts on value names, e.g. "STATUS_" for
+	// STATUS_ACTIVE, stripped when matching by name
+	Prefix string
+	// Values is the generated name-to-number map, e.g. pb.Status_value, which ToProto
+	// needs to match by name and uses to reject undefined numbers when matching by number
+	Values map[string]int32
+	// Strict makes values without a counterpart an error. Otherwise FromProto returns the
+	// set's unknown sentinel, or the zero enum, and ToProto returns 0, the proto3
+	// "unspecified" value, so newer peers can add values without breaking older ones.
+	Strict bool
+}
+
+// FromProto translates a protoc-generated enum value into the enum of the set it stands
+// for, pairing them as configured by opts. A nil opts matches by number leniently.
+func FromProto[T Enum, P ProtoEnum](protoVal P, set *EnumSet[T], opts *ProtoOptions) (T, error) {
+	if opts == nil {
+		opts = &ProtoOptions{}
+	}
+	var enum T
+	var err error
+	if opts.Match == ProtoByName {
+		name := strings.TrimPrefix(protoVal.String(), opts.Prefix)
+		enum, err = set.GetByNameE(name)
+		if err != nil {
+			// Proto value names are upper case, whatever the case sensitivity of the set
+			for _, candidate := range set.Values() {
+				if strings.EqualFold(candidate.String(), name) {
+					enum, err = candidate, nil
+					break
+				}
+			}
+		}
+	} else {
+		enum, err = set.GetByValueE(int(protoVal))
+	}
+	if err == nil || opts.Strict {
+		return enum, err
+	}
+	enum, _ = set.Unknown()
+	return enum, nil
+}
+
+// ToProto translates an enum into the protoc-generated enum type P, pairing them as
+// configured by opts, e.g. ToProto[pb.Status](Active, nil). A nil opts matches by number
+// leniently.
+func ToProto[P ProtoEnum](enum Enum, opts *ProtoOptions) (P, error) {
+	if opts == nil {
+		opts = &ProtoOptions{}
+	}
+	number, err := protoNumber(enum, opts)
+	if err != nil && opts.Strict {
+		return 0, err
+	}
+	return P(number), nil
+}
+
+// protoNumber returns the proto number paired with enum
+func protoNumber(enum Enum, opts *ProtoOptions) (int32, error) {
+	if isZeroEnum(enum) {
+		return 0, fmt.Errorf("cannot translate a nil enum to proto")
+	}
+	if opts.Match == ProtoByName {
+		if opts.Values == nil {
+			return 0, fmt.Errorf("matching enum %s to proto by name needs the generated name-to-number map", enum.String())
+		}
+		names := append([]string{enum.String()}, enum.Aliases()...)
+		for _, name := range names {
+			if number, exists := opts.Values[opts.Prefix+name]; exists {
+				return number, nil
+			}
+			if number, exists := opts.Values[opts.Prefix+strings.ToUpper(name)]; exists {
+				return number, nil
+			}
+		}
+		return 0, fmt.Errorf("enum %s has no proto value named %s%s", enum.String(), opts.Prefix, strings.ToUpper(enum.String()))
+	}
+	number, err := ValueAs[int32](enum)
+	if err != nil {
+		return 0, fmt.Errorf("enum %s has no proto number: %w", enum.String(), err)
+	}
+	if opts.Values != nil {
+		for _, defined := range opts.Values {
+			if defined == number {
+				return number, nil
+			}
+		}
+		return 0, fmt.Errorf("enum %s has no proto value numbered %d", enum.String(), number)
+	}
+	return number, nil
+}
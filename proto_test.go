@@ -0,0 +1,87 @@
+package goenum
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ProtoStatus mimics a protoc-generated enum
+type ProtoStatus int32
+
+const (
+	ProtoStatusUnspecified ProtoStatus = 0
+	ProtoStatusActive      ProtoStatus = 1
+	ProtoStatusClosed      ProtoStatus = 2
+	ProtoStatusArchived    ProtoStatus = 3
+)
+
+var ProtoStatus_name = map[int32]string{0: "STATUS_UNSPECIFIED", 1: "STATUS_ACTIVE", 2: "STATUS_CLOSED", 3: "STATUS_ARCHIVED"}
+
+var ProtoStatus_value = map[string]int32{"STATUS_UNSPECIFIED": 0, "STATUS_ACTIVE": 1, "STATUS_CLOSED": 2, "STATUS_ARCHIVED": 3}
+
+func (s ProtoStatus) String() string {
+	return ProtoStatus_name[int32(s)]
+}
+
+func TestProto(t *testing.T) {
+	set := NewEnumSet[*EnumBase]()
+	active := NewEnumBase(1, "ACTIVE", "Active")
+	closed := NewEnumBase(20, "Closed", "Closed")
+	set.Register(active)
+	set.Register(closed)
+	byName := &ProtoOptions{Match: ProtoByName, Prefix: "STATUS_", Values: ProtoStatus_value}
+
+	t.Run("FromProto()", func(t *testing.T) {
+		enum, err := FromProto(ProtoStatusActive, set, nil)
+		assert.NoError(t, err)
+		assert.Same(t, active, enum)
+
+		enum, err = FromProto(ProtoStatusClosed, set, byName)
+		assert.NoError(t, err)
+		assert.Same(t, closed, enum)
+	})
+
+	t.Run("ToProto()", func(t *testing.T) {
+		status, err := ToProto[ProtoStatus](active, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, ProtoStatusActive, status)
+
+		status, err = ToProto[ProtoStatus](closed, byName)
+		assert.NoError(t, err)
+		assert.Equal(t, ProtoStatusClosed, status)
+	})
+
+	t.Run("unknown values", func(t *testing.T) {
+		enum, err := FromProto(ProtoStatusArchived, set, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, enum, "lenient FromProto() should return the zero enum without a sentinel")
+
+		_, err = FromProto(ProtoStatusArchived, set, &ProtoOptions{Strict: true})
+		assert.True(t, errors.Is(err, ErrUnknownEnum))
+
+		status, err := ToProto[ProtoStatus](closed, &ProtoOptions{Values: ProtoStatus_value})
+		assert.NoError(t, err)
+		assert.Equal(t, ProtoStatusUnspecified, status, "lenient ToProto() should return unspecified")
+
+		_, err = ToProto[ProtoStatus](NewEnumBase(4, "PAUSED", "Paused"), &ProtoOptions{Match: ProtoByName, Prefix: "STATUS_", Values: ProtoStatus_value, Strict: true})
+		assert.EqualError(t, err, "enum PAUSED has no proto value named STATUS_PAUSED")
+		_, err = ToProto[ProtoStatus](active, &ProtoOptions{Match: ProtoByName, Strict: true})
+		assert.Error(t, err, "matching by name should need the generated map")
+		_, err = ToProto[ProtoStatus](closed, &ProtoOptions{Values: ProtoStatus_value, Strict: true})
+		assert.EqualError(t, err, "enum Closed has no proto value numbered 20")
+		_, err = ToProto[ProtoStatus](nil, &ProtoOptions{Strict: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown sentinel", func(t *testing.T) {
+		sentinel := NewEnumBase(-1, "UNKNOWN", "Unknown")
+		withUnknown := NewEnumSet[*EnumBase]()
+		withUnknown.Register(sentinel)
+		withUnknown.SetUnknown(sentinel)
+		enum, err := FromProto(ProtoStatusActive, withUnknown, nil)
+		assert.NoError(t, err)
+		assert.Same(t, sentinel, enum)
+	})
+}
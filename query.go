@@ -0,0 +1,174 @@
+package goenum
+
+import "sort"
+
+// ForEach calls fn for every enum in the set, in registration order
+func (es *EnumSet[T]) ForEach(fn func(T)) {
+	for i, name := range es.order {
+		if es.registeredAt(i) {
+			fn(es.values[name])
+		}
+	}
+}
+
+// Any reports whether at least one enum in the set satisfies the predicate, testing them in
+// registration order
+func (es *EnumSet[T]) Any(predicate func(T) bool) bool {
+	for i, name := range es.order {
+		if es.registeredAt(i) && predicate(es.values[name]) {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether every enum in the set satisfies the predicate, testing them in
+// registration order
+func (es *EnumSet[T]) All(predicate func(T) bool) bool {
+	for i, name := range es.order {
+		if es.registeredAt(i) && !predicate(es.values[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapTo transforms every enum in the set using fn and returns the results in registration order
+func MapTo[T Enum, R any](es *EnumSet[T], fn func(T) R) []R {
+	result := make([]R, 0, len(es.values))
+	es.ForEach(func(enum T) {
+		result = append(result, fn(enum))
+	})
+	return result
+}
+
+// Reduce folds all enums in the set into a single value in registration order, starting
+// from initial
+func Reduce[T Enum, A any](es *EnumSet[T], initial A, fn func(A, T) A) A {
+	acc := initial
+	es.ForEach(func(enum T) {
+		acc = fn(acc, enum)
+	})
+	return acc
+}
+
+// GroupBy groups the enums of the set by the key fn returns for each, every group
+// in registration order
+func GroupBy[T Enum, K comparable](es *EnumSet[T], fn func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for _, enum := range es.Values() {
+		key := fn(enum)
+		result[key] = append(result[key], enum)
+	}
+	return result
+}
+
+// Partition splits the enums of the set into those satisfying the predicate and the rest,
+// both in registration order
+func (es *EnumSet[T]) Partition(predicate func(T) bool) (matched, rest []T) {
+	for _, enum := range es.Values() {
+		if predicate(enum) {
+			matched = append(matched, enum)
+		} else {
+			rest = append(rest, enum)
+		}
+	}
+	return matched, rest
+}
+
+// EnumQuery selects, orders and pages the enums of a set; build one with Query
+type EnumQuery[T Enum] struct {
+	set        *EnumSet[T]
+	predicates []func(T) bool
+	orderings  []func(a, b T) bool
+	offset     int
+	limit      int
+}
+
+// Query starts a query over the enums of the set, e.g.
+//
+//	set.Query().Where(active).SortBy(byValue).Offset(20).Limit(10).Result()
+//
+// Results are deterministic: enums are in registration order unless sorted otherwise,
+// and SortBy keeps that order among equal enums.
+func (es *EnumSet[T]) Query() *EnumQuery[T] {
+	return &EnumQuery[T]{set: es, limit: -1}
+}
+
+// Where keeps only the enums satisfying the predicate; several predicates must all hold
+func (q *EnumQuery[T]) Where(predicate func(T) bool) *EnumQuery[T] {
+	q.predicates = append(q.predicates, predicate)
+	return q
+}
+
+// SortBy orders the result with less; later orderings break ties of earlier ones
+func (q *EnumQuery[T]) SortBy(less func(a, b T) bool) *EnumQuery[T] {
+	q.orderings = append(q.orderings, less)
+	return q
+}
+
+// Offset skips the first n matching enums
+func (q *EnumQuery[T]) Offset(n int) *EnumQuery[T] {
+	q.offset = n
+	return q
+}
+
+// Limit returns at most n enums; a negative n removes the limit
+func (q *EnumQuery[T]) Limit(n int) *EnumQuery[T] {
+	q.limit = n
+	return q
+}
+
+// Count returns the number of matching enums, ignoring Offset and Limit
+func (q *EnumQuery[T]) Count() int {
+	return len(q.matching())
+}
+
+// Result runs the query and returns the selected page of enums
+func (q *EnumQuery[T]) Result() []T {
+	result := q.matching()
+	if q.offset >= len(result) {
+		return []T{}
+	}
+	if q.offset > 0 {
+		result = result[q.offset:]
+	}
+	if q.limit >= 0 && q.limit < len(result) {
+		result = result[:q.limit]
+	}
+	return result
+}
+
+// matching returns the enums satisfying every predicate, in query order
+func (q *EnumQuery[T]) matching() []T {
+	result := make([]T, 0, len(q.set.values))
+	for _, enum := range q.set.Values() {
+		if q.matches(enum) {
+			result = append(result, enum)
+		}
+	}
+	if len(q.orderings) > 0 {
+		sort.SliceStable(result, func(i, j int) bool {
+			for _, less := range q.orderings {
+				if less(result[i], result[j]) {
+					return true
+				}
+				if less(result[j], result[i]) {
+					return false
+				}
+			}
+			return false
+		})
+	}
+	return result
+}
+
+// matches reports whether enum satisfies every predicate of the query
+func (q *EnumQuery[T]) matches(enum T) bool {
+	for _, predicate := range q.predicates {
+		if !predicate(enum) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package goenum
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetFunctionalHelpers(t *testing.T) {
+	t.Run("ForEach() method", func(t *testing.T) {
+		visited := make([]string, 0)
+		TestEnumSet.ForEach(func(e TestEnum) {
+			visited = append(visited, e.String())
+		})
+		assert.ElementsMatch(t, []string{"A", "B", "C"}, visited, "ForEach() should visit every enum")
+	})
+
+	t.Run("Any() method", func(t *testing.T) {
+		assert.True(t, TestEnumSet.Any(func(e TestEnum) bool {
+			return e.HasAlias("BETA")
+		}), "Any() should return true when one enum matches")
+		assert.False(t, TestEnumSet.Any(func(e TestEnum) bool {
+			return e.Value().(int) > 10
+		}), "Any() should return false when no enum matches")
+		assert.False(t, NewEnumSet[TestEnum]().Any(func(TestEnum) bool { return true }), "Any() should return false for empty set")
+	})
+
+	t.Run("All() method", func(t *testing.T) {
+		assert.True(t, TestEnumSet.All(func(e TestEnum) bool {
+			return e.Value().(int) > 0
+		}), "All() should return true when every enum matches")
+		assert.False(t, TestEnumSet.All(func(e TestEnum) bool {
+			return e.Value().(int) > 1
+		}), "All() should return false when one enum does not match")
+		assert.True(t, NewEnumSet[TestEnum]().All(func(TestEnum) bool { return false }), "All() should return true for empty set")
+	})
+
+	t.Run("MapTo() function", func(t *testing.T) {
+		descriptions := MapTo(TestEnumSet, func(e TestEnum) string {
+			return e.Description()
+		})
+		assert.ElementsMatch(t, []string{"First enum", "Second enum", "Third enum"}, descriptions, "MapTo() should transform every enum")
+	})
+
+	t.Run("Reduce() function", func(t *testing.T) {
+		sum := Reduce(TestEnumSet, 0, func(acc int, e TestEnum) int {
+			return acc + e.Value().(int)
+		})
+		assert.Equal(t, 6, sum, "Reduce() should fold all enum values")
+
+		aliasCount := Reduce(TestEnumSet, 0, func(acc int, e TestEnum) int {
+			return acc + len(e.Aliases())
+		})
+		assert.Equal(t, 4, aliasCount, "Reduce() should count all aliases")
+	})
+
+	t.Run("GroupBy() function", func(t *testing.T) {
+		byParity := GroupBy(TestEnumSet, func(e TestEnum) bool {
+			return e.Value().(int)%2 == 0
+		})
+		assert.Equal(t, map[bool][]TestEnum{
+			false: {TestEnumA, TestEnumC},
+			true:  {TestEnumB},
+		}, byParity, "GroupBy() should group enums in registration order")
+		assert.Empty(t, GroupBy(NewEnumSet[TestEnum](), func(TestEnum) string { return "" }))
+	})
+
+	t.Run("Partition() method", func(t *testing.T) {
+		matched, rest := TestEnumSet.Partition(func(e TestEnum) bool {
+			return len(e.Aliases()) > 1
+		})
+		assert.Equal(t, []TestEnum{TestEnumC}, matched)
+		assert.Equal(t, []TestEnum{TestEnumA, TestEnumB}, rest)
+
+		matched, rest = TestEnumSet.Partition(func(TestEnum) bool { return false })
+		assert.Nil(t, matched)
+		assert.Len(t, rest, 3)
+	})
+
+	t.Run("registration order", func(t *testing.T) {
+		set := NewEnumSet[*EnumBase]().
+			Register(NewEnumBase(3, "ZULU", "")).
+			Register(NewEnumBase(1, "ALPHA", "")).
+			Register(NewEnumBase(2, "MIKE", "")).
+			Register(NewEnumBase(4, "BRAVO", ""))
+		set.Unregister("MIKE")
+		expected := []string{"ZULU", "ALPHA", "BRAVO"}
+		name := func(e *EnumBase) string { return e.String() }
+
+		visited := make([]string, 0)
+		set.ForEach(func(e *EnumBase) { visited = append(visited, e.String()) })
+		assert.Equal(t, expected, visited, "ForEach() should follow registration order")
+		assert.Equal(t, expected, MapTo(set, name), "MapTo() should follow registration order")
+		assert.Equal(t, expected, Reduce(set, []string{}, func(acc []string, e *EnumBase) []string {
+			return append(acc, e.String())
+		}), "Reduce() should follow registration order")
+		assert.Equal(t, expected, set.Names(), "Names() should follow registration order")
+		assert.Equal(t, map[bool][]*EnumBase{true: set.Values()}, GroupBy(set, func(*EnumBase) bool { return true }))
+		_, rest := set.Partition(func(*EnumBase) bool { return false })
+		assert.Equal(t, set.Values(), rest, "Partition() should follow registration order")
+		assert.Equal(t, set.Values(), set.Query().Result(), "Query() should default to registration order")
+	})
+}
+
+func TestEnumQuery(t *testing.T) {
+	set := NewEnumSet[*EnumBase]()
+	for i := 1; i <= 30; i++ {
+		set.Register(NewEnumBase(i, fmt.Sprintf("CODE_%02d", i), "").WithGroup([]string{"even", "odd"}[i%2]))
+	}
+	byValueDesc := func(a, b *EnumBase) bool { return a.Value().(int) > b.Value().(int) }
+	names := func(enums []*EnumBase) []string {
+		result := make([]string, len(enums))
+		for i, enum := range enums {
+			result[i] = enum.String()
+		}
+		return result
+	}
+
+	t.Run("default order and paging", func(t *testing.T) {
+		assert.Len(t, set.Query().Result(), 30)
+		assert.Equal(t, []string{"CODE_21", "CODE_22"}, names(set.Query().Offset(20).Limit(2).Result()))
+		assert.Empty(t, set.Query().Offset(30).Result())
+		assert.Empty(t, set.Query().Limit(0).Result())
+	})
+
+	t.Run("Where() and SortBy()", func(t *testing.T) {
+		query := set.Query().
+			Where(func(e *EnumBase) bool { return e.Group() == "odd" }).
+			Where(func(e *EnumBase) bool { return e.Value().(int) > 10 }).
+			SortBy(byValueDesc)
+		assert.Equal(t, 10, query.Count())
+		assert.Equal(t, []string{"CODE_29", "CODE_27", "CODE_25"}, names(query.Limit(3).Result()))
+		assert.Equal(t, []string{"CODE_23", "CODE_21"}, names(query.Offset(3).Limit(2).Result()))
+	})
+
+	t.Run("stable ties", func(t *testing.T) {
+		byGroup := func(a, b *EnumBase) bool { return a.Group() < b.Group() }
+		result := set.Query().SortBy(byGroup).Limit(3).Result()
+		assert.Equal(t, []string{"CODE_02", "CODE_04", "CODE_06"}, names(result), "ties should keep registration order")
+
+		result = set.Query().SortBy(byGroup).SortBy(byValueDesc).Limit(2).Result()
+		assert.Equal(t, []string{"CODE_30", "CODE_28"}, names(result), "later orderings should break ties")
+	})
+}
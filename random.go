@@ -0,0 +1,74 @@
+package goenum
+
+import "math/rand"
+
+// Random returns a uniformly chosen enum from the set.
+// Passing the same seeded source to a set registered in the same order yields the same
+// sequence of picks; a nil source uses the global one.
+func (es *EnumSet[T]) Random(r *rand.Rand) (T, bool) {
+	var zero T
+	if len(es.values) == 0 {
+		return zero, false
+	}
+
+	var n int
+	if r == nil {
+		n = rand.Intn(len(es.values))
+	} else {
+		n = r.Intn(len(es.values))
+	}
+	if es.unregistered == 0 {
+		return es.values[es.order[n]], true
+	}
+	for i, name := range es.order {
+		if !es.registeredAt(i) {
+			continue
+		}
+		if n == 0 {
+			return es.values[name], true
+		}
+		n--
+	}
+	return zero, false
+}
+
+// RandomWeighted returns an enum chosen with probability proportional to its weight by name.
+// Enums without a positive weight are never picked; a nil source uses the global one.
+func (es *EnumSet[T]) RandomWeighted(r *rand.Rand, weights map[string]float64) (T, bool) {
+	var zero T
+	total := 0.0
+	es.ForEach(func(enum T) {
+		if w := weights[enum.String()]; w > 0 {
+			total += w
+		}
+	})
+	if total == 0 {
+		return zero, false
+	}
+
+	var pick float64
+	if r == nil {
+		pick = rand.Float64() * total
+	} else {
+		pick = r.Float64() * total
+	}
+
+	var last T
+	for i, name := range es.order {
+		if !es.registeredAt(i) {
+			continue
+		}
+		enum := es.values[name]
+		w := weights[name]
+		if w <= 0 {
+			continue
+		}
+		if pick < w {
+			return enum, true
+		}
+		pick -= w
+		last = enum
+	}
+	// Guard against floating point rounding on the final bucket
+	return last, true
+}
@@ -0,0 +1,65 @@
+package goenum
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetRandom(t *testing.T) {
+	t.Run("Random() is reproducible", func(t *testing.T) {
+		first := make([]string, 0, 20)
+		second := make([]string, 0, 20)
+		r1, r2 := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42))
+		for i := 0; i < 20; i++ {
+			a, ok := TestEnumSet.Random(r1)
+			assert.True(t, ok)
+			b, _ := TestEnumSet.Random(r2)
+			first = append(first, a.String())
+			second = append(second, b.String())
+		}
+		assert.Equal(t, first, second, "Random() should yield the same picks for the same seed")
+	})
+
+	t.Run("Random() returns registered values", func(t *testing.T) {
+		enum, ok := TestEnumSet.Random(nil)
+		assert.True(t, ok, "Random() should pick a value with the global source")
+		assert.True(t, TestEnumSet.Contains(enum), "Random() should return a registered enum")
+
+		_, ok = NewEnumSet[TestEnum]().Random(nil)
+		assert.False(t, ok, "Random() should return false for empty set")
+	})
+
+	t.Run("Random() skips unregistered values", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).Register(TestEnumC)
+		set.Unregister("B")
+		r := rand.New(rand.NewSource(1))
+		counts := make(map[string]int)
+		for i := 0; i < 200; i++ {
+			enum, ok := set.Random(r)
+			assert.True(t, ok)
+			counts[enum.String()]++
+		}
+		assert.Zero(t, counts["B"], "Random() should never pick an unregistered value")
+		assert.Positive(t, counts["A"], "Random() should pick every registered value")
+		assert.Positive(t, counts["C"], "Random() should pick every registered value")
+	})
+
+	t.Run("RandomWeighted() respects weights", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		counts := make(map[string]int)
+		for i := 0; i < 1000; i++ {
+			enum, ok := TestEnumSet.RandomWeighted(r, map[string]float64{"A": 3, "B": 1, "C": 0})
+			assert.True(t, ok)
+			counts[enum.String()]++
+		}
+		assert.Zero(t, counts["C"], "RandomWeighted() should never pick zero-weight values")
+		assert.Greater(t, counts["A"], counts["B"], "RandomWeighted() should favor heavier weights")
+	})
+
+	t.Run("RandomWeighted() without weights", func(t *testing.T) {
+		_, ok := TestEnumSet.RandomWeighted(nil, map[string]float64{"UNKNOWN": 1, "A": -1})
+		assert.False(t, ok, "RandomWeighted() should return false when no registered value has positive weight")
+	})
+}
@@ -0,0 +1,104 @@
+package goenum
+
+import (
+	"fmt"
+	"sort"
+)
+
+// valueRange is an inclusive numeric range covered by an enum
+type valueRange struct {
+	min float64
+	max float64
+}
+
+// rangedEnum is implemented by enums that cover a numeric range
+type rangedEnum interface {
+	Range() (min float64, max float64, ok bool)
+}
+
+// rangeEntry associates a registered enum with its range
+type rangeEntry[T Enum] struct {
+	valueRange
+	enum T
+}
+
+// WithRange declares the inclusive numeric range the enum covers and returns the EnumBase for chaining
+func (e *EnumBase) WithRange(min, max float64) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	e.valueRange = &valueRange{min: min, max: max}
+	return e
+}
+
+// Range returns the inclusive numeric range the enum covers, if any
+func (e *EnumBase) Range() (min float64, max float64, ok bool) {
+	if e == nil || e.valueRange == nil {
+		return 0, 0, false
+	}
+	return e.valueRange.min, e.valueRange.max, true
+}
+
+// rangeOf returns the range declared by an enum, if any
+func rangeOf(enum Enum) (valueRange, bool) {
+	r, ok := enum.(rangedEnum)
+	if !ok {
+		return valueRange{}, false
+	}
+	min, max, ok := r.Range()
+	return valueRange{min: min, max: max}, ok
+}
+
+// checkRange returns an error if the enum's range is invalid or overlaps a registered range
+func (es *EnumSet[T]) checkRange(enum T) error {
+	r, ok := rangeOf(enum)
+	if !ok {
+		return nil
+	}
+	if r.min > r.max {
+		return fmt.Errorf("invalid range for enum %s: min %v is greater than max %v", enum.String(), r.min, r.max)
+	}
+	for _, entry := range es.ranges {
+		if r.min <= entry.max && entry.min <= r.max {
+			return fmt.Errorf("range %v-%v of enum %s overlaps range %v-%v of enum %s",
+				r.min, r.max, enum.String(), entry.min, entry.max, entry.enum.String())
+		}
+	}
+	return nil
+}
+
+// addRange stores the enum's range, keeping ranges sorted by their lower bound
+func (es *EnumSet[T]) addRange(enum T) {
+	r, ok := rangeOf(enum)
+	if !ok {
+		return
+	}
+	i := sort.Search(len(es.ranges), func(i int) bool {
+		return es.ranges[i].min > r.min
+	})
+	es.ranges = append(es.ranges, rangeEntry[T]{})
+	copy(es.ranges[i+1:], es.ranges[i:])
+	es.ranges[i] = rangeEntry[T]{valueRange: r, enum: enum}
+}
+
+// removeRange deletes the enum's range
+func (es *EnumSet[T]) removeRange(enum T) {
+	for i, entry := range es.ranges {
+		if entry.enum.String() == enum.String() {
+			es.ranges = append(es.ranges[:i], es.ranges[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetByRange retrieves the enum whose declared range contains v
+func (es *EnumSet[T]) GetByRange(v float64) (T, bool) {
+	i := sort.Search(len(es.ranges), func(i int) bool {
+		return es.ranges[i].min > v
+	})
+	if i > 0 && v <= es.ranges[i-1].max {
+		return es.ranges[i-1].enum, true
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,68 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetRanges(t *testing.T) {
+	var (
+		success     = TestEnum{NewEnumBase(1, "SUCCESS", "2xx responses").WithRange(200, 299)}
+		redirect    = TestEnum{NewEnumBase(2, "REDIRECT", "3xx responses").WithRange(300, 399)}
+		clientError = TestEnum{NewEnumBase(3, "CLIENT_ERROR", "4xx responses").WithRange(400, 499)}
+		unranged    = TestEnum{NewEnumBase(4, "OTHER", "No range")}
+	)
+	newRangeSet := func() *EnumSet[TestEnum] {
+		return NewEnumSet[TestEnum]().
+			Register(clientError).
+			Register(success).
+			Register(unranged).
+			Register(redirect)
+	}
+
+	t.Run("Range() accessor", func(t *testing.T) {
+		min, max, ok := success.Range()
+		assert.True(t, ok, "Range() should report declared range")
+		assert.Equal(t, 200.0, min)
+		assert.Equal(t, 299.0, max)
+
+		_, _, ok = unranged.Range()
+		assert.False(t, ok, "Range() should report no range for plain enum")
+	})
+
+	t.Run("lookup by range", func(t *testing.T) {
+		set := newRangeSet()
+		for v, expected := range map[float64]TestEnum{
+			200: success, 250: success, 299: success,
+			300: redirect, 399: redirect,
+			404: clientError, 499: clientError,
+		} {
+			enum, exists := set.GetByRange(v)
+			assert.True(t, exists, "GetByRange(%v) should find enum", v)
+			assert.Equal(t, expected, enum, "GetByRange(%v) should return correct enum", v)
+		}
+	})
+
+	t.Run("lookup outside ranges", func(t *testing.T) {
+		set := newRangeSet()
+		for _, v := range []float64{100, 199.9, 500} {
+			_, exists := set.GetByRange(v)
+			assert.False(t, exists, "GetByRange(%v) should return false outside declared ranges", v)
+		}
+		_, exists := NewEnumSet[TestEnum]().GetByRange(200)
+		assert.False(t, exists, "GetByRange() should return false for empty set")
+	})
+
+	t.Run("overlapping range", func(t *testing.T) {
+		set := newRangeSet()
+		err := set.TryRegister(TestEnum{NewEnumBase(5, "OK", "Overlaps success").WithRange(250, 260)})
+		assert.Error(t, err, "TryRegister() should reject overlapping range")
+		assert.Contains(t, err.Error(), "SUCCESS", "error should name the conflicting enum")
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		err := NewEnumSet[TestEnum]().TryRegister(TestEnum{NewEnumBase(5, "BAD", "Inverted").WithRange(10, 1)})
+		assert.Error(t, err, "TryRegister() should reject range with min greater than max")
+	})
+}
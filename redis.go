@@ -0,0 +1,130 @@
+package goenum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+)
+
+// RedisClient is the subset of Redis commands RedisLoader needs. With go-redis:
+//
+//	type redisClient struct{ rdb *redis.Client }
+//
+//	func (c redisClient) Get(ctx context.Context, key string) ([]byte, error) {
+//		return c.rdb.Get(ctx, key).Bytes()
+//	}
+//
+//	func (c redisClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+//		return c.rdb.HGetAll(ctx, key).Result()
+//	}
+type RedisClient interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+}
+
+// RedisSubscriber delivers invalidation messages published on a Redis channel.
+// The returned channel is closed when the subscription ends.
+type RedisSubscriber interface {
+	Subscribe(ctx context.Context, channel string) (<-chan string, error)
+}
+
+// RedisLoader loads a shared enum catalog from Redis and keeps it current
+type RedisLoader struct {
+	// Client reads the catalog
+	Client RedisClient
+	// Key holds a JSON array of EnumDefinition, or a hash when Hash is set
+	Key string
+	// Hash reads Key as a hash mapping enum names to JSON definitions
+	// ({"value": 1, "description": "...", "aliases": [...], "group": "..."})
+	Hash bool
+	// Options validate the loaded definitions; nil uses DefaultValidationOptions
+	Options *ValidationOptions
+
+	current atomic.Pointer[EnumSet[Enum]]
+}
+
+// Load reads the catalog and atomically replaces the current set, which is left
+// unchanged on error
+func (r *RedisLoader) Load(ctx context.Context) (*EnumSet[Enum], error) {
+	loader := NewDynamicEnumLoader(r.Options)
+	if r.Hash {
+		fields, err := r.Client.HGetAll(ctx, r.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read redis hash %s: %w", r.Key, err)
+		}
+		definitions, err := redisHashDefinitions(fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redis hash %s: %w", r.Key, err)
+		}
+		if err := loader.LoadFromSlice(definitions); err != nil {
+			return nil, err
+		}
+	} else {
+		data, err := r.Client.Get(ctx, r.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read redis key %s: %w", r.Key, err)
+		}
+		if err := loader.LoadFromReader(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	}
+
+	set := loader.GetEnumSet()
+	r.current.Store(set)
+	return set, nil
+}
+
+// EnumSet returns the most recently loaded set, or nil before the first successful Load
+func (r *RedisLoader) EnumSet() *EnumSet[Enum] {
+	return r.current.Load()
+}
+
+// Watch reloads the catalog every time a message is published on channel, until ctx is
+// done or the subscription ends. Reload errors are passed to onError, if not nil, and keep
+// the previous set.
+func (r *RedisLoader) Watch(ctx context.Context, subscriber RedisSubscriber, channel string, onError func(error)) error {
+	messages, err := subscriber.Subscribe(ctx, channel)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to redis channel %s: %w", channel, err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			if _, err := r.Load(ctx); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// redisHashDefinitions decodes a hash of enum names to JSON definitions, sorted by name
+func redisHashDefinitions(fields map[string]string) ([]EnumDefinition, error) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	definitions := make([]EnumDefinition, 0, len(names))
+	var errs []error
+	for _, name := range names {
+		var def EnumDefinition
+		if err := json.Unmarshal([]byte(fields[name]), &def); err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", name, err))
+			continue
+		}
+		// Numbers stay float64, so the loader coerces them and warns about fractions
+		def.Name = name
+		definitions = append(definitions, def)
+	}
+	return definitions, errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+package goenum
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRedis is an in-memory RedisClient and RedisSubscriber
+type fakeRedis struct {
+	mu       sync.Mutex
+	strings  map[string][]byte
+	hashes   map[string]map[string]string
+	messages chan string
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{
+		strings:  make(map[string][]byte),
+		hashes:   make(map[string]map[string]string),
+		messages: make(chan string),
+	}
+}
+
+func (f *fakeRedis) set(key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.strings[key] = []byte(value)
+}
+
+func (f *fakeRedis) Get(_ context.Context, key string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, exists := f.strings[key]
+	if !exists {
+		return nil, errors.New("redis: nil")
+	}
+	return data, nil
+}
+
+func (f *fakeRedis) HGetAll(_ context.Context, key string) (map[string]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.hashes[key], nil
+}
+
+func (f *fakeRedis) Subscribe(_ context.Context, _ string) (<-chan string, error) {
+	return f.messages, nil
+}
+
+func TestRedisLoader(t *testing.T) {
+	options := &ValidationOptions{DuplicateHandling: DuplicateSkip}
+
+	t.Run("JSON key", func(t *testing.T) {
+		redis := newFakeRedis()
+		redis.set("enums:status", `[{"name": "ACTIVE", "value": 1}, {"name": "PENDING", "value": 0}]`)
+		loader := &RedisLoader{Client: redis, Key: "enums:status", Options: options}
+		assert.Nil(t, loader.EnumSet(), "EnumSet() should be nil before loading")
+
+		set, err := loader.Load(context.Background())
+		assert.NoError(t, err)
+		assert.Same(t, set, loader.EnumSet())
+		enum, exists := set.GetByName("ACTIVE")
+		assert.True(t, exists)
+		assert.Equal(t, 1, enum.Value())
+	})
+
+	t.Run("hash key", func(t *testing.T) {
+		redis := newFakeRedis()
+		redis.hashes["enums:status"] = map[string]string{
+			"ACTIVE":  `{"value": 1, "description": "Active", "aliases": ["RUNNING"], "group": "open"}`,
+			"PENDING": `{"value": 0}`,
+		}
+		loader := &RedisLoader{Client: redis, Key: "enums:status", Hash: true, Options: options}
+		set, err := loader.Load(context.Background())
+		assert.NoError(t, err)
+		enum, exists := set.GetByName("RUNNING")
+		assert.True(t, exists, "aliases should be loaded from the hash")
+		assert.Equal(t, 1, enum.Value(), "values should be converted to int")
+		assert.Equal(t, "open", groupOf(enum))
+	})
+
+	t.Run("hash values are coerced by the loader", func(t *testing.T) {
+		definitions, err := redisHashDefinitions(map[string]string{"HALF": `{"value": 1.5}`})
+		assert.NoError(t, err)
+		assert.Equal(t, 1.5, definitions[0].Value, "numbers should reach the loader unchanged")
+
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromSlice(definitions))
+		assert.Equal(t, []LoadWarning{{Kind: WarningValueCoerced, Name: "HALF", Message: "value 1.5 truncated to 1"}},
+			loader.Warnings(), "fractional values should be reported")
+	})
+
+	t.Run("errors keep the previous set", func(t *testing.T) {
+		redis := newFakeRedis()
+		redis.set("enums", `[{"name": "A", "value": 1}]`)
+		loader := &RedisLoader{Client: redis, Key: "enums", Options: options}
+		previous, err := loader.Load(context.Background())
+		assert.NoError(t, err)
+
+		redis.set("enums", `not json`)
+		_, err = loader.Load(context.Background())
+		assert.Error(t, err)
+		assert.Same(t, previous, loader.EnumSet(), "a failed load should not replace the set")
+
+		_, err = (&RedisLoader{Client: redis, Key: "missing"}).Load(context.Background())
+		assert.EqualError(t, err, "failed to read redis key missing: redis: nil")
+
+		redis.hashes["bad"] = map[string]string{"A": `{"value":`}
+		_, err = (&RedisLoader{Client: redis, Key: "bad", Hash: true}).Load(context.Background())
+		assert.ErrorContains(t, err, "invalid redis hash bad: field A:")
+	})
+
+	t.Run("Watch() reloads on invalidation", func(t *testing.T) {
+		redis := newFakeRedis()
+		redis.set("enums", `[{"name": "A", "value": 1}]`)
+		loader := &RedisLoader{Client: redis, Key: "enums", Options: options}
+		_, err := loader.Load(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		var reloadErrs []error
+		go func() {
+			done <- loader.Watch(ctx, redis, "enums:invalidate", func(err error) { reloadErrs = append(reloadErrs, err) })
+		}()
+
+		redis.set("enums", `[{"name": "A", "value": 1}, {"name": "B", "value": 2}]`)
+		redis.messages <- "changed"
+		redis.set("enums", `broken`)
+		redis.messages <- "changed"
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("Watch() should return when the context is canceled")
+		}
+		assert.ElementsMatch(t, []string{"A", "B"}, loader.EnumSet().Names(), "the last valid catalog should be current")
+		assert.Len(t, reloadErrs, 1, "reload errors should be reported")
+	})
+}
@@ -324,7 +324,9 @@ func (r *EnumReflection) GetEnumSet() (*EnumSet[Enum], error) {
 	for i := 0; i < values.Len(); i++ {
 		value := values.Index(i)
 		if enum, ok := value.Interface().(Enum); ok {
-			enumSet.Register(enum)
+			if err := enumSet.TryRegister(enum); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -509,3 +511,51 @@ func (r *EnumReflection) GetEnumConstants() (map[string]interface{}, error) {
 	}
 	return constants, nil
 }
+
+// AutoRegister registers every given enum in the set, skipping nil pointers.
+// It stops at and returns the first registration error.
+func AutoRegister[T Enum](set *EnumSet[T], enums ...*T) error {
+	for _, enum := range enums {
+		if enum == nil {
+			continue
+		}
+		if err := set.TryRegister(*enum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterFields registers every exported field of type T in the given struct (or pointer to struct).
+// Go cannot enumerate package-level variables at runtime, so group enum values in a struct:
+//
+//	var Statuses = struct{ Pending, Active Status }{...}
+//	goenum.RegisterFields(StatusEnumSet, Statuses)
+func RegisterFields[T Enum](set *EnumSet[T], container interface{}) error {
+	v := reflect.ValueOf(container)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return fmt.Errorf("cannot register fields of nil container")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("container type %v is not a struct", v.Type())
+	}
+
+	enumType := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() || field.Type != enumType {
+			continue
+		}
+		enum := v.Field(i).Interface().(T)
+		if reflect.ValueOf(&enum).Elem().IsZero() {
+			return fmt.Errorf("field %s is not initialized", field.Name)
+		}
+		if err := set.TryRegister(enum); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
@@ -200,3 +200,43 @@ func TestEnumReflectionEdgeCases(t *testing.T) {
 		assert.Empty(t, constants)
 	})
 }
+
+func TestAutoRegistration(t *testing.T) {
+	t.Run("AutoRegister()", func(t *testing.T) {
+		set := NewEnumSet[ReflectionTestEnum]()
+		err := AutoRegister(set, &ReflectionTestEnumA, &ReflectionTestEnumB, nil, &ReflectionTestEnumC)
+		assert.NoError(t, err)
+		assert.Len(t, set.Values(), 3, "AutoRegister() should register every enum")
+	})
+
+	t.Run("AutoRegister() with duplicate", func(t *testing.T) {
+		set := NewEnumSet[ReflectionTestEnum]()
+		err := AutoRegister(set, &ReflectionTestEnumA, &ReflectionTestEnumA)
+		assert.Error(t, err, "AutoRegister() should return registration errors")
+	})
+
+	t.Run("RegisterFields()", func(t *testing.T) {
+		container := struct {
+			A     ReflectionTestEnum
+			B     ReflectionTestEnum
+			C     ReflectionTestEnum
+			Other string
+			d     ReflectionTestEnum
+		}{A: ReflectionTestEnumA, B: ReflectionTestEnumB, C: ReflectionTestEnumC, Other: "ignored"}
+
+		set := NewEnumSet[ReflectionTestEnum]()
+		assert.NoError(t, RegisterFields(set, &container))
+		assert.ElementsMatch(t, []string{"A", "B", "C"}, set.Names(), "RegisterFields() should register exported enum fields")
+	})
+
+	t.Run("RegisterFields() errors", func(t *testing.T) {
+		set := NewEnumSet[ReflectionTestEnum]()
+		assert.Error(t, RegisterFields(set, 42), "RegisterFields() should reject non-struct containers")
+
+		var nilContainer *struct{ A ReflectionTestEnum }
+		assert.Error(t, RegisterFields(set, nilContainer), "RegisterFields() should reject nil containers")
+
+		uninitialized := struct{ A ReflectionTestEnum }{}
+		assert.EqualError(t, RegisterFields(set, uninitialized), "field A is not initialized")
+	})
+}
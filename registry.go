@@ -0,0 +1,153 @@
+package goenum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// registry holds the package-level named enum sets, and a channel closed and replaced on
+// every change
+var registry = struct {
+	sync.RWMutex
+	sets    map[string]AnyEnumSet
+	changed chan struct{}
+}{sets: make(map[string]AnyEnumSet), changed: make(chan struct{})}
+
+// RegisterSet adds a named enum set to the global registry, prefixed with the namespace of
+// the set if it has one. It panics if the name is empty or already registered, unless in
+// panic-free mode.
+func RegisterSet(name string, set AnyEnumSet) {
+	if err := TryRegisterSet(name, set); err != nil {
+		fail(err)
+	}
+}
+
+// TryRegisterSet adds a named enum set to the global registry, returning an error
+// instead of panicking
+func TryRegisterSet(name string, set AnyEnumSet) error {
+	if name == "" {
+		return fmt.Errorf("enum set name cannot be empty")
+	}
+	if set == nil {
+		return fmt.Errorf("cannot register nil enum set: %s", name)
+	}
+	name = qualifySetName(set, name)
+
+	registry.Lock()
+	defer registry.Unlock()
+	if _, exists := registry.sets[name]; exists {
+		return fmt.Errorf("duplicate enum set name: %s", name)
+	}
+	registry.sets[name] = set
+	broadcastRegistryChange()
+	return nil
+}
+
+// RegisterSetOf adds an enum set to the global registry under the name of its enum type,
+// e.g. "Status" for *EnumSet[Status], or "payments.Status" in the "payments" namespace
+func RegisterSetOf[T Enum](set *EnumSet[T]) {
+	RegisterSet(typeName[T](), set)
+}
+
+// typeName returns the name of the enum type T, or its full type string for unnamed types
+func typeName[T Enum]() string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Name() == "" {
+		return t.String()
+	}
+	return t.Name()
+}
+
+// UnregisterSet removes a named enum set from the global registry
+func UnregisterSet(name string) {
+	registry.Lock()
+	defer registry.Unlock()
+	if _, exists := registry.sets[name]; exists {
+		delete(registry.sets, name)
+		broadcastRegistryChange()
+	}
+}
+
+// RegistryChanged returns a channel that is closed at the next change to the global
+// registry, so watchers can wait for changes instead of polling
+func RegistryChanged() <-chan struct{} {
+	registry.RLock()
+	defer registry.RUnlock()
+	return registry.changed
+}
+
+// NotifyRegistryChanged wakes RegistryChanged waiters, for sets changed in place after
+// they were registered
+func NotifyRegistryChanged() {
+	registry.Lock()
+	defer registry.Unlock()
+	broadcastRegistryChange()
+}
+
+// broadcastRegistryChange closes the current change channel and replaces it; the caller
+// must hold the registry lock
+func broadcastRegistryChange() {
+	close(registry.changed)
+	registry.changed = make(chan struct{})
+}
+
+// LookupSet retrieves a named enum set from the global registry
+func LookupSet(name string) (AnyEnumSet, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	set, exists := registry.sets[name]
+	return set, exists
+}
+
+// LookupSetOf retrieves a named enum set of a known enum type from the global registry
+func LookupSetOf[T Enum](name string) (*EnumSet[T], bool) {
+	set, exists := LookupSet(name)
+	if !exists {
+		return nil, false
+	}
+	typed, ok := set.(*EnumSet[T])
+	return typed, ok
+}
+
+// AllSets returns a copy of all enum sets in the global registry keyed by name
+func AllSets() map[string]AnyEnumSet {
+	registry.RLock()
+	defer registry.RUnlock()
+	result := make(map[string]AnyEnumSet, len(registry.sets))
+	for name, set := range registry.sets {
+		result[name] = set
+	}
+	return result
+}
+
+// SetNames returns the sorted names of all enum sets in the global registry
+func SetNames() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	names := make([]string, 0, len(registry.sets))
+	for name := range registry.sets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetByName retrieves an enum by set name and enum name or alias from the global registry
+func GetByName(setName, name string) (Enum, bool) {
+	set, exists := LookupSet(setName)
+	if !exists {
+		return nil, false
+	}
+	return set.EnumByName(name)
+}
+
+// GetByValue retrieves an enum by set name and value from the global registry
+func GetByValue(setName string, value interface{}) (Enum, bool) {
+	set, exists := LookupSet(setName)
+	if !exists {
+		return nil, false
+	}
+	return set.EnumByValue(value)
+}
@@ -0,0 +1,113 @@
+package goenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobalRegistry(t *testing.T) {
+	RegisterSet("RegistryTest", TestEnumSet)
+	t.Cleanup(func() { UnregisterSet("RegistryTest") })
+
+	t.Run("LookupSet()", func(t *testing.T) {
+		set, exists := LookupSet("RegistryTest")
+		assert.True(t, exists, "LookupSet() should find registered set")
+		enum, exists := set.EnumByName("B")
+		assert.True(t, exists)
+		assert.Equal(t, TestEnumB, enum)
+
+		_, exists = LookupSet("Missing")
+		assert.False(t, exists, "LookupSet() should return false for unknown set")
+	})
+
+	t.Run("LookupSetOf()", func(t *testing.T) {
+		set, exists := LookupSetOf[TestEnum]("RegistryTest")
+		assert.True(t, exists, "LookupSetOf() should find set of matching type")
+		assert.Equal(t, TestEnumSet, set)
+
+		_, exists = LookupSetOf[ReflectionTestEnum]("RegistryTest")
+		assert.False(t, exists, "LookupSetOf() should return false for mismatched type")
+	})
+
+	t.Run("AllSets() and SetNames()", func(t *testing.T) {
+		assert.Contains(t, AllSets(), "RegistryTest")
+		assert.Contains(t, SetNames(), "RegistryTest")
+
+		all := AllSets()
+		delete(all, "RegistryTest")
+		_, exists := LookupSet("RegistryTest")
+		assert.True(t, exists, "AllSets() should return a copy")
+	})
+
+	t.Run("invalid registrations", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterSet("RegistryTest", TestEnumSet) }, "RegisterSet() should panic on duplicate name")
+		assert.Panics(t, func() { RegisterSet("", TestEnumSet) }, "RegisterSet() should panic on empty name")
+		assert.Panics(t, func() { RegisterSet("Nil", nil) }, "RegisterSet() should panic on nil set")
+	})
+
+	t.Run("UnregisterSet()", func(t *testing.T) {
+		RegisterSet("Temporary", TestEnumSet)
+		UnregisterSet("Temporary")
+		_, exists := LookupSet("Temporary")
+		assert.False(t, exists, "UnregisterSet() should remove the set")
+	})
+
+	t.Run("RegistryChanged()", func(t *testing.T) {
+		changed := RegistryChanged()
+		UnregisterSet("Missing")
+		assert.False(t, isClosed(changed), "removing a missing set should not signal a change")
+
+		RegisterSet("Temporary", TestEnumSet)
+		assert.True(t, isClosed(changed), "RegisterSet() should signal a change")
+
+		changed = RegistryChanged()
+		UnregisterSet("Temporary")
+		assert.True(t, isClosed(changed), "UnregisterSet() should signal a change")
+
+		changed = RegistryChanged()
+		NotifyRegistryChanged()
+		assert.True(t, isClosed(changed), "NotifyRegistryChanged() should signal a change")
+		assert.False(t, isClosed(RegistryChanged()), "each change should replace the channel")
+	})
+}
+
+// isClosed reports whether a change channel has been closed
+func isClosed(changed <-chan struct{}) bool {
+	select {
+	case <-changed:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestGlobalRegistryLookups(t *testing.T) {
+	RegisterSetOf(ReflectionTestEnumSet)
+	t.Cleanup(func() { UnregisterSet("ReflectionTestEnum") })
+
+	t.Run("RegisterSetOf() uses type name", func(t *testing.T) {
+		_, exists := LookupSet("ReflectionTestEnum")
+		assert.True(t, exists, "RegisterSetOf() should register under the enum type name")
+	})
+
+	t.Run("GetByName()", func(t *testing.T) {
+		enum, exists := GetByName("ReflectionTestEnum", "beta")
+		assert.True(t, exists)
+		assert.Equal(t, ReflectionTestEnumB, enum)
+
+		_, exists = GetByName("ReflectionTestEnum", "MISSING")
+		assert.False(t, exists, "GetByName() should return false for unknown enum")
+		_, exists = GetByName("Missing", "A")
+		assert.False(t, exists, "GetByName() should return false for unknown set")
+	})
+
+	t.Run("GetByValue()", func(t *testing.T) {
+		enum, exists := GetByValue("ReflectionTestEnum", 3)
+		assert.True(t, exists)
+		assert.Equal(t, ReflectionTestEnumC, enum)
+
+		_, exists = GetByValue("Missing", 3)
+		assert.False(t, exists, "GetByValue() should return false for unknown set")
+	})
+}
@@ -0,0 +1,281 @@
+package goenum
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// DefinitionSchema is the JSON Schema describing enum definition files
+//
+//go:embed enum-definitions.schema.json
+var DefinitionSchema string
+
+// definitionFields maps each field allowed in a definition to its expected JSON type
+var definitionFields = map[string]string{
+	"name":        "string",
+	"value":       "scalar",
+	"description": "string",
+	"aliases":     "array of strings",
+	"group":       "string",
+	"displayName": "string",
+	"meta":        "object",
+	"tags":        "array of strings",
+}
+
+// requiredDefinitionFields lists the fields every definition must have
+var requiredDefinitionFields = []string{"name", "value"}
+
+// SchemaError reports a part of a definition document that does not match DefinitionSchema,
+// or a violation found by a DocumentValidator. Line is 0 when the position is unknown.
+type SchemaError struct {
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+// Error implements the error interface
+func (e *SchemaError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s: %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+// ValidateDefinitions checks a definition document against DefinitionSchema,
+// returning every *SchemaError found joined into one error
+func ValidateDefinitions(data []byte) error {
+	v := &schemaValidator{data: data, dec: json.NewDecoder(bytes.NewReader(data))}
+	v.dec.UseNumber()
+	if err := v.document(); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return v.errorAt(syntaxErr.Offset, "$", syntaxErr.Error())
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return v.errorAt(int64(len(data)), "$", "unexpected end of JSON input")
+		}
+		return err
+	}
+	return errors.Join(v.errs...)
+}
+
+// LoadFromJSONValidated validates a JSON file against DefinitionSchema before loading it
+func (l *DynamicEnumLoader) LoadFromJSONValidated(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	if l.options.JSONC {
+		if data, err = stripJSONC(data); err != nil {
+			return fmt.Errorf("invalid JSONC in %s: %w", filename, err)
+		}
+	}
+	if err := ValidateDefinitions(data); err != nil {
+		return fmt.Errorf("invalid enum definitions in %s: %w", filename, err)
+	}
+	return withFile(l.LoadFromReader(bytes.NewReader(data)), filename)
+}
+
+// schemaValidator walks a definition document token by token, tracking positions
+type schemaValidator struct {
+	data []byte
+	dec  *json.Decoder
+	errs []error
+}
+
+// document validates the top-level array; a returned error aborts validation
+func (v *schemaValidator) document() error {
+	start := v.dec.InputOffset()
+	tok, err := v.dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('[') {
+		return v.errorAt(start, "$", "expected an array of enum definitions")
+	}
+	for i := 0; v.dec.More(); i++ {
+		if err := v.definition(i); err != nil {
+			return err
+		}
+	}
+	if _, err := v.dec.Token(); err != nil {
+		return err
+	}
+	if _, err := v.dec.Token(); err != io.EOF {
+		return v.errorAt(v.dec.InputOffset(), "$", "unexpected data after the definitions array")
+	}
+	return nil
+}
+
+// definition validates one element of the top-level array
+func (v *schemaValidator) definition(index int) error {
+	path := fmt.Sprintf("$[%d]", index)
+	start := v.dec.InputOffset()
+	tok, err := v.dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		v.errs = append(v.errs, v.errorAt(start, path, "expected an object"))
+		return v.skip(tok)
+	}
+
+	seen := make(map[string]bool)
+	for v.dec.More() {
+		keyStart := v.dec.InputOffset()
+		tok, err := v.dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+		fieldPath := path + "." + key
+		expected, known := definitionFields[key]
+		if !known {
+			v.errs = append(v.errs, v.errorAt(keyStart, fieldPath,
+				fmt.Sprintf("unknown field (allowed: %s)", strings.Join(sortedDefinitionFields(), ", "))))
+		}
+		seen[key] = true
+
+		valueStart := v.dec.InputOffset()
+		tok, err = v.dec.Token()
+		if err != nil {
+			return err
+		}
+		if !known {
+			if err := v.skip(tok); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := v.field(tok, expected, fieldPath, valueStart); err != nil {
+			return err
+		}
+	}
+	if _, err := v.dec.Token(); err != nil {
+		return err
+	}
+
+	for _, field := range requiredDefinitionFields {
+		if !seen[field] {
+			v.errs = append(v.errs, v.errorAt(start, path, fmt.Sprintf("missing required field %q", field)))
+		}
+	}
+	return nil
+}
+
+// field validates the value of a known definition field
+func (v *schemaValidator) field(tok json.Token, expected, path string, start int64) error {
+	switch expected {
+	case "string":
+		if _, ok := tok.(string); !ok {
+			v.errs = append(v.errs, v.errorAt(start, path, "expected string, got "+jsonKind(tok)))
+		}
+	case "scalar":
+		if _, ok := tok.(json.Delim); ok {
+			v.errs = append(v.errs, v.errorAt(start, path, "expected string, number, boolean or null, got "+jsonKind(tok)))
+		}
+	case "object":
+		if tok != json.Delim('{') {
+			v.errs = append(v.errs, v.errorAt(start, path, "expected object, got "+jsonKind(tok)))
+		}
+	case "array of strings":
+		if tok != json.Delim('[') {
+			v.errs = append(v.errs, v.errorAt(start, path, "expected array of strings, got "+jsonKind(tok)))
+			break
+		}
+		for i := 0; v.dec.More(); i++ {
+			itemStart := v.dec.InputOffset()
+			item, err := v.dec.Token()
+			if err != nil {
+				return err
+			}
+			if _, ok := item.(string); !ok {
+				v.errs = append(v.errs, v.errorAt(itemStart, fmt.Sprintf("%s[%d]", path, i), "expected string, got "+jsonKind(item)))
+			}
+			if err := v.skip(item); err != nil {
+				return err
+			}
+		}
+		_, err := v.dec.Token()
+		return err
+	}
+	return v.skip(tok)
+}
+
+// skip consumes the rest of a value whose first token has already been read
+func (v *schemaValidator) skip(tok json.Token) error {
+	if tok != json.Delim('[') && tok != json.Delim('{') {
+		return nil
+	}
+	for depth := 1; depth > 0; {
+		tok, err := v.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('['), json.Delim('{'):
+			depth++
+		case json.Delim(']'), json.Delim('}'):
+			depth--
+		}
+	}
+	return nil
+}
+
+// errorAt builds a SchemaError at the first token found at or after offset
+func (v *schemaValidator) errorAt(offset int64, path, message string) *SchemaError {
+	if offset > int64(len(v.data)) {
+		offset = int64(len(v.data))
+	}
+	for offset < int64(len(v.data)) && bytes.IndexByte([]byte(" \t\r\n,:"), v.data[offset]) >= 0 {
+		offset++
+	}
+	line, column := 1, 1
+	for _, b := range v.data[:offset] {
+		if b == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return &SchemaError{Line: line, Column: column, Path: path, Message: message}
+}
+
+// jsonKind names the JSON type of a token for error messages
+func jsonKind(tok json.Token) string {
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '[' {
+			return "array"
+		}
+		return "object"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", tok)
+}
+
+// sortedDefinitionFields returns the allowed definition field names, sorted
+func sortedDefinitionFields() []string {
+	fields := make([]string, 0, len(definitionFields))
+	for field := range definitionFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,109 @@
+package goenum
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefinitionSchema(t *testing.T) {
+	t.Run("schema is valid JSON", func(t *testing.T) {
+		var schema map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(DefinitionSchema), &schema))
+		assert.Equal(t, "array", schema["type"], "schema should describe an array of definitions")
+	})
+
+	t.Run("valid document", func(t *testing.T) {
+		data := []byte(`[
+  {"name": "A", "value": 1, "description": "First", "aliases": ["ALPHA"], "group": "g"},
+  {"name": "B", "value": "b"},
+  {"name": "C", "value": null}
+]`)
+		assert.NoError(t, ValidateDefinitions(data))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		data := []byte(`[
+  {"name": "A", "value": 1,
+   "label": "First"}
+]`)
+		err := ValidateDefinitions(data)
+		assert.EqualError(t, err, `line 3, column 4: $[0].label: unknown field (allowed: aliases, description, displayName, group, meta, name, tags, value)`)
+
+		var schemaErr *SchemaError
+		assert.True(t, errors.As(err, &schemaErr), "errors should be *SchemaError")
+		assert.Equal(t, 3, schemaErr.Line)
+		assert.Equal(t, "$[0].label", schemaErr.Path)
+	})
+
+	t.Run("wrong types", func(t *testing.T) {
+		data := []byte(`[
+  {"name": 1, "value": {"x": 1}},
+  {"name": "B", "value": 2, "aliases": ["X", 3]},
+  {"name": "C", "value": 3, "aliases": "X"}
+]`)
+		err := ValidateDefinitions(data)
+		assert.EqualError(t, err, `line 2, column 12: $[0].name: expected string, got number
+line 2, column 24: $[0].value: expected string, number, boolean or null, got object
+line 3, column 46: $[1].aliases[1]: expected string, got number
+line 4, column 40: $[2].aliases: expected array of strings, got string`)
+	})
+
+	t.Run("missing required fields", func(t *testing.T) {
+		err := ValidateDefinitions([]byte(`[{"name": "A"}, 5]`))
+		assert.EqualError(t, err, `line 1, column 2: $[0]: missing required field "value"
+line 1, column 17: $[1]: expected an object`)
+	})
+
+	t.Run("not an array", func(t *testing.T) {
+		err := ValidateDefinitions([]byte(`{"name": "A"}`))
+		assert.EqualError(t, err, `line 1, column 1: $: expected an array of enum definitions`)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		err := ValidateDefinitions([]byte("[\n  {\"name\": \"A\",}\n]"))
+		var schemaErr *SchemaError
+		assert.True(t, errors.As(err, &schemaErr), "syntax errors should carry a position")
+		assert.Equal(t, 2, schemaErr.Line, "syntax error should be on line 2")
+	})
+
+	t.Run("truncated document", func(t *testing.T) {
+		err := ValidateDefinitions([]byte(`[{"name": "A"`))
+		assert.EqualError(t, err, `line 1, column 14: $: unexpected end of JSON input`)
+	})
+}
+
+func TestLoadFromJSONValidated(t *testing.T) {
+	tempDir := t.TempDir()
+
+	t.Run("loads valid file", func(t *testing.T) {
+		file := filepath.Join(tempDir, "valid.json")
+		assert.NoError(t, os.WriteFile(file, []byte(`[{"name": "A", "value": 1, "description": "First"}]`), 0644))
+
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip})
+		assert.NoError(t, loader.LoadFromJSONValidated(file))
+		enum, exists := loader.GetEnumSet().GetByName("A")
+		assert.True(t, exists, "enum should be loaded")
+		assert.Equal(t, 1, enum.Value())
+	})
+
+	t.Run("rejects invalid file before loading", func(t *testing.T) {
+		file := filepath.Join(tempDir, "invalid.json")
+		assert.NoError(t, os.WriteFile(file, []byte(`[{"name": "A", "value": 1}, {"name": "B", "valeu": 2}]`), 0644))
+
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip})
+		err := loader.LoadFromJSONValidated(file)
+		assert.ErrorContains(t, err, "$[1].valeu: unknown field")
+		assert.ErrorContains(t, err, `$[1]: missing required field "value"`)
+		assert.Empty(t, loader.GetEnumSet().Values(), "nothing should be loaded from an invalid file")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		assert.Error(t, loader.LoadFromJSONValidated(filepath.Join(tempDir, "missing.json")))
+	})
+}
@@ -0,0 +1,61 @@
+package goenum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Setting holds an enum read from configuration such as environment variables. It
+// implements the Decoder and Setter interfaces of envconfig and similar libraries, and
+// flag.Value, resolving names and aliases through the set registered for T with
+// RegisterSetOf:
+//
+//	type Config struct {
+//		Mode goenum.Setting[Mode] `envconfig:"MODE"`
+//	}
+type Setting[T Enum] struct {
+	Enum T
+}
+
+// Decode implements envconfig.Decoder, see DecodeEnum
+func (s *Setting[T]) Decode(value string) error {
+	set, err := registeredSetOf[T]()
+	if err != nil {
+		return err
+	}
+	return DecodeEnum(set, &s.Enum, value)
+}
+
+// Set implements envconfig.Setter and flag.Value, like Decode
+func (s *Setting[T]) Set(value string) error {
+	return s.Decode(value)
+}
+
+// String implements flag.Value, returning the name of the enum
+func (s *Setting[T]) String() string {
+	if s == nil || isZeroEnum(s.Enum) {
+		return ""
+	}
+	return s.Enum.String()
+}
+
+// DecodeEnum resolves a configuration value against the set by name or alias and stores
+// the result in target. An empty value stores the default of the set, and is an error
+// if the set has none. It is intended for Decode and Set implementations on enum types.
+func DecodeEnum[T Enum](set *EnumSet[T], target *T, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		enum, exists := set.Default()
+		if !exists {
+			return fmt.Errorf("no value given and %s has no default", set.setName())
+		}
+		*target = enum
+		return nil
+	}
+	enum, err := set.GetByNameE(value)
+	if err != nil {
+		return err
+	}
+	*target = enum
+	return nil
+}
@@ -0,0 +1,58 @@
+package goenum
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type SettingMode struct{ *EnumBase }
+
+func TestSetting(t *testing.T) {
+	debug := SettingMode{NewEnumBase(1, "DEBUG", "Debug logging", "verbose")}
+	release := SettingMode{NewEnumBase(2, "RELEASE", "Release mode")}
+	set := NewEnumSet[SettingMode]()
+	set.Register(debug)
+	set.Register(release)
+	set.SetDefault(release)
+	RegisterSetOf(set)
+	t.Cleanup(func() { UnregisterSet("SettingMode") })
+
+	t.Run("Decode()", func(t *testing.T) {
+		var mode Setting[SettingMode]
+		assert.NoError(t, mode.Decode(" verbose "))
+		assert.Equal(t, debug, mode.Enum)
+
+		assert.NoError(t, mode.Decode(""), "empty values should use the default")
+		assert.Equal(t, release, mode.Enum)
+
+		err := mode.Decode("TRACE")
+		assert.True(t, errors.Is(err, ErrUnknownEnum))
+	})
+
+	t.Run("flag.Value", func(t *testing.T) {
+		var mode Setting[SettingMode]
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags.Var(&mode, "mode", "run mode")
+		assert.NoError(t, flags.Parse([]string{"-mode", "DEBUG"}))
+		assert.Equal(t, "DEBUG", mode.String())
+		assert.Equal(t, "", (&Setting[SettingMode]{}).String())
+	})
+
+	t.Run("DecodeEnum()", func(t *testing.T) {
+		noDefault := NewEnumSet[SettingMode]()
+		noDefault.Register(debug)
+		var mode SettingMode
+		assert.EqualError(t, DecodeEnum(noDefault, &mode, ""), "no value given and SettingMode has no default")
+		assert.NoError(t, DecodeEnum(noDefault, &mode, "DEBUG"))
+		assert.Equal(t, debug, mode)
+	})
+
+	t.Run("unregistered type", func(t *testing.T) {
+		type UnregisteredMode struct{ *EnumBase }
+		var mode Setting[UnregisteredMode]
+		assert.EqualError(t, mode.Set("DEBUG"), "no enum set registered for type UnregisteredMode")
+	})
+}
@@ -0,0 +1,122 @@
+package goenum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownEnum is matched by errors.Is for every *UnknownEnumError
+var ErrUnknownEnum = errors.New("unknown enum")
+
+// UnknownEnumError reports an input that does not match any registered enum
+type UnknownEnumError struct {
+	// Input is the name or value that was not recognized
+	Input string
+	// Allowed lists the registered names in registration order
+	Allowed []string
+	// Set names the enum set that was searched, when known
+	Set string
+	// Suggestions lists the registered names, aliases or values nearest to Input, closest first
+	Suggestions []string
+}
+
+// newUnknownEnumError builds an UnknownEnumError listing the names registered in set
+func newUnknownEnumError(input string, set AnyEnumSet) *UnknownEnumError {
+	var allowed []string
+	if set != nil {
+		allowed = set.Names()
+	}
+	return &UnknownEnumError{Input: input, Allowed: allowed}
+}
+
+// Error implements the error interface, e.g.
+// `unknown enum "ACTIV" in Status, did you mean ACTIVE? (allowed: ACTIVE, PAUSED)`
+func (e *UnknownEnumError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "unknown enum %q", e.Input)
+	if e.Set != "" {
+		fmt.Fprintf(&b, " in %s", e.Set)
+	}
+	if len(e.Suggestions) > 0 {
+		fmt.Fprintf(&b, ", did you mean %s?", strings.Join(e.Suggestions, " or "))
+	}
+	if len(e.Allowed) > 0 {
+		fmt.Fprintf(&b, " (allowed: %s)", strings.Join(e.Allowed, ", "))
+	}
+	return b.String()
+}
+
+// Is reports whether target is ErrUnknownEnum
+func (e *UnknownEnumError) Is(target error) bool {
+	return target == ErrUnknownEnum
+}
+
+// unmarshalStrict decodes data and resolves it against the set the enum was first registered
+// in, or the configuration's Set, falling back to the set's unknown sentinel, and copies the
+// resolved enum's state on success. Enums held by a set are never changed: strict decoding
+// needs a fresh value, such as a Clone of a registered enum.
+func (e *EnumBase) unmarshalStrict(data []byte, config *EnumJSONConfig) error {
+	if e.held {
+		return fmt.Errorf("strict unmarshaling into enum %s held by a set; decode into a copy", e.name)
+	}
+	owner := e.owner
+	if config.Set != nil {
+		var ok bool
+		if owner, ok = config.Set.(enumOwner); !ok {
+			return fmt.Errorf("strict unmarshaling requires an enum set, got %T", config.Set)
+		}
+	}
+	if owner == nil {
+		return fmt.Errorf("strict unmarshaling requires an enum registered in a set or a configured Set")
+	}
+
+	lenient := *config
+	lenient.Strict = false
+	decoded := &EnumBase{}
+	if err := decoded.UnmarshalJSONWithConfig(data, &lenient); err != nil {
+		return err
+	}
+
+	var (
+		enum   Enum
+		exists bool
+		old    interface{}
+		input  string
+	)
+	byValue := config.Format == JSONFormatValue
+	if config.UnmarshalFunc != nil {
+		byValue = decoded.name == ""
+	}
+	if byValue {
+		enum, exists = owner.EnumByValue(decoded.value)
+		old, input = decoded.value, fmt.Sprint(decoded.value)
+	} else {
+		enum, exists = owner.EnumByName(decoded.name)
+		old, input = decoded.name, decoded.name
+	}
+	if !exists {
+		var current string
+		if current, exists = owner.ownerMigrated(old); exists {
+			enum, exists = owner.EnumByName(current)
+		}
+	}
+	if !exists {
+		enum, exists = owner.ownerUnknown()
+	}
+	if !exists {
+		return newUnknownEnumError(input, owner)
+	}
+
+	e.name = enum.String()
+	e.value = enum.Value()
+	e.description = enum.Description()
+	e.aliases = cloneStrings(enum.Aliases())
+	e.group = groupOf(enum)
+	e.displayName = displayNameOf(enum)
+	e.tags = cloneStrings(tagsOf(enum))
+	if e.owner == nil {
+		e.owner = owner
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package goenum
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictUnmarshal(t *testing.T) {
+	newStrictSet := func(format JSONFormat) (*EnumSet[TestEnum], *EnumBase) {
+		target := NewEnumBase(0, "TARGET", "Decoding target")
+		set := NewEnumSet[TestEnum]().
+			Register(TestEnum{target}).
+			Register(TestEnum{NewEnumBase(1, "ONE", "First", "UNO")}).
+			SetJSONConfig(&EnumJSONConfig{Format: format, Strict: true})
+		return set, target
+	}
+
+	t.Run("registered name", func(t *testing.T) {
+		_, target := newStrictSet(JSONFormatName)
+		decoded := target.Clone()
+		assert.NoError(t, json.Unmarshal([]byte(`"uno"`), decoded))
+		assert.Equal(t, "ONE", decoded.String(), "strict unmarshal should resolve aliases")
+		assert.Equal(t, 1, decoded.Value(), "strict unmarshal should restore the value")
+		assert.Equal(t, "First", decoded.Description())
+	})
+
+	t.Run("set unchanged", func(t *testing.T) {
+		set, target := newStrictSet(JSONFormatName)
+		assert.NoError(t, json.Unmarshal([]byte(`"uno"`), target.Clone()))
+
+		err := json.Unmarshal([]byte(`"uno"`), target)
+		assert.Error(t, err, "strict unmarshal should not change an enum held by a set")
+		assert.NotErrorIs(t, err, ErrUnknownEnum)
+
+		registered, exists := set.GetByName("TARGET")
+		assert.True(t, exists)
+		assert.Equal(t, 0, registered.Value())
+		assert.Equal(t, "Decoding target", registered.Description())
+		assert.Empty(t, registered.Aliases())
+		one, _ := set.GetByName("UNO")
+		assert.Equal(t, "ONE", one.String())
+		assert.Len(t, set.Values(), 2)
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		_, target := newStrictSet(JSONFormatName)
+		decoded := target.Clone()
+		err := json.Unmarshal([]byte(`"TWO"`), decoded)
+		assert.ErrorIs(t, err, ErrUnknownEnum)
+
+		var unknown *UnknownEnumError
+		assert.True(t, errors.As(err, &unknown))
+		assert.Equal(t, "TWO", unknown.Input)
+		assert.Equal(t, []string{"TARGET", "ONE"}, unknown.Allowed, "allowed names should be in registration order")
+		assert.Equal(t, "TARGET", decoded.String(), "target should be untouched on error")
+	})
+
+	t.Run("value format", func(t *testing.T) {
+		_, target := newStrictSet(JSONFormatValue)
+		decoded := target.Clone()
+		assert.NoError(t, json.Unmarshal([]byte(`1`), decoded))
+		assert.Equal(t, "ONE", decoded.String())
+
+		err := json.Unmarshal([]byte(`5`), decoded)
+		assert.EqualError(t, err, `unknown enum "5" (allowed: TARGET, ONE)`)
+	})
+
+	t.Run("configured set", func(t *testing.T) {
+		set, _ := newStrictSet(JSONFormatName)
+		decoded := &EnumBase{}
+		assert.NoError(t, decoded.UnmarshalJSONWithConfig([]byte(`"ONE"`), &EnumJSONConfig{Strict: true, Set: set}))
+		assert.Equal(t, 1, decoded.Value())
+		assert.Equal(t, []string{"UNO"}, decoded.Aliases())
+	})
+
+	t.Run("not registered in a set", func(t *testing.T) {
+		err := (&EnumBase{}).UnmarshalJSONWithConfig([]byte(`"ONE"`), &EnumJSONConfig{Strict: true})
+		assert.Error(t, err, "strict unmarshal should fail without a set")
+		assert.NotErrorIs(t, err, ErrUnknownEnum)
+	})
+
+	t.Run("lenient by default", func(t *testing.T) {
+		decoded := &EnumBase{}
+		assert.NoError(t, json.Unmarshal([]byte(`"ANYTHING"`), decoded))
+		assert.Equal(t, "ANYTHING", decoded.String())
+	})
+
+	t.Run("error without allowed values", func(t *testing.T) {
+		assert.Equal(t, `unknown enum "X"`, newUnknownEnumError("X", nil).Error())
+	})
+}
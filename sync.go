@@ -0,0 +1,225 @@
+package goenum
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncEnumSet is an EnumSet safe for concurrent use. Reads go to an immutable snapshot
+// loaded atomically, so lookups never contend; writes copy the snapshot, change the copy
+// and publish it, so they are serialized and cost O(n). Use it for catalogs updated
+// rarely at runtime and read at high rates.
+type SyncEnumSet[T Enum] struct {
+	mu       sync.Mutex // serializes writers
+	snapshot atomic.Pointer[EnumSet[T]]
+
+	reindexMu sync.Mutex
+	reindex   []string // enums whose aliases changed, indexed by the next write
+}
+
+// NewSyncEnumSet creates a new, empty SyncEnumSet
+func NewSyncEnumSet[T Enum]() *SyncEnumSet[T] {
+	s := &SyncEnumSet[T]{}
+	s.snapshot.Store(NewEnumSet[T]())
+	return s
+}
+
+// Snapshot returns the current contents of the set. The returned set must not be modified;
+// use Update instead.
+func (s *SyncEnumSet[T]) Snapshot() *EnumSet[T] {
+	return s.snapshot.Load()
+}
+
+// Update calls fn with a copy of the current snapshot and publishes the copy if fn
+// returns nil, so several changes become visible to readers at once
+func (s *SyncEnumSet[T]) Update(fn func(set *EnumSet[T]) error) error {
+	err := s.update(fn)
+	// Aliases changed while this write held the lock may have missed it
+	s.reindexMu.Lock()
+	missed := len(s.reindex) > 0
+	s.reindexMu.Unlock()
+	if missed {
+		s.update(func(*EnumSet[T]) error { return nil })
+	}
+	return err
+}
+
+// update runs fn on a copy of the snapshot under the writer lock and publishes it, with
+// the aliases of enums changed since the last write indexed, unless fn fails
+func (s *SyncEnumSet[T]) update(fn func(set *EnumSet[T]) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	next := s.snapshot.Load().clone()
+	err := fn(next)
+
+	s.reindexMu.Lock()
+	names := s.reindex
+	s.reindex = nil
+	s.reindexMu.Unlock()
+	if err != nil {
+		if len(names) == 0 {
+			return err
+		}
+		next = s.snapshot.Load().clone()
+	}
+	for _, name := range names {
+		next.ownerIndexAliases(name)
+	}
+	s.snapshot.Store(next)
+	return err
+}
+
+// Register adds an enum value to the set and returns the SyncEnumSet for chaining.
+// It panics if the enum is a duplicate or fails validation, unless in panic-free mode.
+func (s *SyncEnumSet[T]) Register(enum T) *SyncEnumSet[T] {
+	if err := s.TryRegister(enum); err != nil {
+		fail(err)
+	}
+	return s
+}
+
+// TryRegister adds an enum value to the set, returning an error instead of panicking
+func (s *SyncEnumSet[T]) TryRegister(enum T) error {
+	return s.Update(func(set *EnumSet[T]) error {
+		// Bind to the SyncEnumSet rather than to a snapshot that will be replaced
+		return set.registerOwnedBy(enum, s)
+	})
+}
+
+// Unregister removes the enum registered under name from the set and returns it
+func (s *SyncEnumSet[T]) Unregister(name string) (T, bool) {
+	var (
+		removed T
+		exists  bool
+	)
+	s.Update(func(set *EnumSet[T]) error {
+		if removed, exists = set.Unregister(name); !exists {
+			return fmt.Errorf("enum %s is not registered", name)
+		}
+		return nil
+	})
+	return removed, exists
+}
+
+// GetByName retrieves an enum by its string name or alias
+func (s *SyncEnumSet[T]) GetByName(name string) (T, bool) {
+	return s.snapshot.Load().GetByName(name)
+}
+
+// GetByValue retrieves an enum by its value
+func (s *SyncEnumSet[T]) GetByValue(value interface{}) (T, bool) {
+	return s.snapshot.Load().GetByValue(value)
+}
+
+// Values returns all registered enum values
+func (s *SyncEnumSet[T]) Values() []T {
+	return s.snapshot.Load().Values()
+}
+
+// Names returns a slice of all enum names in the set
+func (s *SyncEnumSet[T]) Names() []string {
+	return s.snapshot.Load().Names()
+}
+
+// Contains checks if an enum exists in the set
+func (s *SyncEnumSet[T]) Contains(enum T) bool {
+	return s.snapshot.Load().Contains(enum)
+}
+
+// EnumByName implements AnyEnumSet
+func (s *SyncEnumSet[T]) EnumByName(name string) (Enum, bool) {
+	return s.snapshot.Load().EnumByName(name)
+}
+
+// EnumByValue implements AnyEnumSet
+func (s *SyncEnumSet[T]) EnumByValue(value interface{}) (Enum, bool) {
+	return s.snapshot.Load().EnumByValue(value)
+}
+
+// ownerJSONConfig implements enumOwner
+func (s *SyncEnumSet[T]) ownerJSONConfig() *EnumJSONConfig {
+	return s.snapshot.Load().ownerJSONConfig()
+}
+
+// ownerUnknown implements enumOwner
+func (s *SyncEnumSet[T]) ownerUnknown() (Enum, bool) {
+	return s.snapshot.Load().ownerUnknown()
+}
+
+// ownerIndexAliases implements enumOwner. The aliases are indexed by the next write;
+// when no writer holds the lock, that write happens here. Otherwise the writer, which
+// may be an Update callback of this goroutine, indexes them before releasing it.
+func (s *SyncEnumSet[T]) ownerIndexAliases(name string) {
+	s.reindexMu.Lock()
+	s.reindex = append(s.reindex, name)
+	s.reindexMu.Unlock()
+	if s.mu.TryLock() {
+		s.mu.Unlock()
+		s.Update(func(*EnumSet[T]) error { return nil })
+	}
+}
+
+// ownerMigrated implements enumOwner
+func (s *SyncEnumSet[T]) ownerMigrated(old interface{}) (string, bool) {
+	return s.snapshot.Load().ownerMigrated(old)
+}
+
+// clone returns a copy of the set whose lookup tables can change without affecting es.
+// Enums, hooks and deprecated alias tracking are shared.
+func (es *EnumSet[T]) clone() *EnumSet[T] {
+	copied := *es
+	copied.values = make(map[string]T, len(es.values))
+	for name, enum := range es.values {
+		copied.values[name] = enum
+	}
+	copied.order = append([]string(nil), es.order...)
+	copied.positions = make(map[string]int, len(es.positions))
+	for name, position := range es.positions {
+		copied.positions[name] = position
+	}
+	copied.byValue = make(map[interface{}]T, len(es.byValue))
+	for value, enum := range es.byValue {
+		copied.byValue[value] = enum
+	}
+	if es.aliasIndex != nil {
+		copied.aliasIndex = make(map[string][]T, len(es.aliasIndex))
+		for alias, enums := range es.aliasIndex {
+			copied.aliasIndex[alias] = append([]T(nil), enums...)
+		}
+	}
+	if es.byExternalCode != nil {
+		copied.byExternalCode = make(map[interface{}]T, len(es.byExternalCode))
+		for code, enum := range es.byExternalCode {
+			copied.byExternalCode[code] = enum
+		}
+	}
+	if es.indexes != nil {
+		copied.indexes = make(map[string]*enumIndex[T], len(es.indexes))
+		for name, index := range es.indexes {
+			entries := make(map[interface{}]T, len(index.entries))
+			for key, enum := range index.entries {
+				entries[key] = enum
+			}
+			copied.indexes[name] = &enumIndex[T]{key: index.key, entries: entries}
+		}
+	}
+	copied.validators = append([]func(T) error(nil), es.validators...)
+	copied.ranges = append([]rangeEntry[T](nil), es.ranges...)
+	copied.errs = append([]error(nil), es.errs...)
+	if es.migrations != nil {
+		migrations := &enumMigrations{
+			names:      make(map[string]string, len(es.migrations.names)),
+			values:     make(map[interface{}]string, len(es.migrations.values)),
+			valueOrder: append([]interface{}(nil), es.migrations.valueOrder...),
+		}
+		for old, current := range es.migrations.names {
+			migrations.names[old] = current
+		}
+		for old, current := range es.migrations.values {
+			migrations.values[old] = current
+		}
+		copied.migrations = migrations
+	}
+	return &copied
+}
@@ -0,0 +1,181 @@
+package goenum
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncEnumSet(t *testing.T) {
+	t.Run("registration and lookups", func(t *testing.T) {
+		set := NewSyncEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB)
+		enum, exists := set.GetByName("alpha")
+		assert.True(t, exists)
+		assert.Equal(t, TestEnumA, enum)
+		enum, exists = set.GetByValue(2)
+		assert.True(t, exists)
+		assert.Equal(t, TestEnumB, enum)
+		assert.ElementsMatch(t, []string{"A", "B"}, set.Names())
+		assert.Error(t, set.TryRegister(TestEnumA))
+
+		removed, exists := set.Unregister("A")
+		assert.True(t, exists)
+		assert.Equal(t, TestEnumA, removed)
+		assert.False(t, set.Contains(TestEnumA))
+		_, exists = set.Unregister("A")
+		assert.False(t, exists)
+	})
+
+	t.Run("snapshots are immutable", func(t *testing.T) {
+		set := NewSyncEnumSet[TestEnum]().Register(TestEnumA)
+		before := set.Snapshot()
+		set.Register(TestEnumB)
+		assert.Len(t, before.Values(), 1, "published snapshots should not change")
+		assert.Len(t, set.Values(), 2)
+	})
+
+	t.Run("Update publishes atomically", func(t *testing.T) {
+		set := NewSyncEnumSet[TestEnum]()
+		err := set.Update(func(s *EnumSet[TestEnum]) error {
+			s.Register(TestEnumA).Register(TestEnumB).SetDefault(TestEnumA)
+			return nil
+		})
+		assert.NoError(t, err)
+		enum, _ := set.Snapshot().Default()
+		assert.Equal(t, TestEnumA, enum)
+
+		err = set.Update(func(s *EnumSet[TestEnum]) error {
+			s.Register(TestEnumC)
+			return fmt.Errorf("rejected")
+		})
+		assert.EqualError(t, err, "rejected")
+		assert.False(t, set.Contains(TestEnumC), "failed updates should not be published")
+	})
+
+	t.Run("enums resolve against the latest snapshot", func(t *testing.T) {
+		set := NewSyncEnumSet[*EnumBase]()
+		active := NewEnumBase(1, "ACTIVE", "Active")
+		set.Register(active)
+		set.Update(func(s *EnumSet[*EnumBase]) error {
+			s.SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
+			return nil
+		})
+		data, err := json.Marshal(active)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", string(data))
+	})
+
+	t.Run("rejected enums stay unbound", func(t *testing.T) {
+		set := NewSyncEnumSet[*EnumBase]().Register(NewEnumBase(1, "ACTIVE", ""))
+		duplicate := NewEnumBase(1, "ENABLED", "")
+		assert.Error(t, set.TryRegister(duplicate))
+		assert.Nil(t, duplicate.owner, "a rejected enum should not be bound to the set")
+		assert.False(t, duplicate.held)
+
+		other := NewEnumSet[*EnumBase]().SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue}).Register(duplicate)
+		assert.Same(t, other, duplicate.owner, "the enum should bind to the set it is registered in")
+	})
+
+	t.Run("deprecated aliases added inside Update", func(t *testing.T) {
+		set := NewSyncEnumSet[*EnumBase]()
+		active := NewEnumBase(1, "ACTIVE", "")
+		done := make(chan error)
+		go func() {
+			done <- set.Update(func(s *EnumSet[*EnumBase]) error {
+				s.Register(active)
+				active.WithDeprecatedAliases("ENABLED")
+				return nil
+			})
+		}()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("WithDeprecatedAliases() inside Update should not deadlock")
+		}
+		enum, exists := set.GetByName("ENABLED")
+		assert.True(t, exists, "the alias should be published with the update")
+		assert.Same(t, active, enum)
+
+		active.WithDeprecatedAliases("ON")
+		_, exists = set.GetByName("ON")
+		assert.True(t, exists, "aliases added outside Update should be published at once")
+
+		err := set.Update(func(*EnumSet[*EnumBase]) error {
+			active.WithDeprecatedAliases("LIVE")
+			return fmt.Errorf("rejected")
+		})
+		assert.EqualError(t, err, "rejected")
+		_, exists = set.GetByName("LIVE")
+		assert.True(t, exists, "aliases should be published even when the update fails")
+	})
+
+	t.Run("deprecated aliases are copied on write", func(t *testing.T) {
+		backing := make([]string, 1, 4)
+		backing[0] = "ENABLED"
+		active := &EnumBase{value: 1, name: "ACTIVE", aliases: backing}
+		NewSyncEnumSet[*EnumBase]().Register(active)
+
+		active.WithDeprecatedAliases("OLD")
+		assert.Equal(t, []string{"ENABLED", "OLD"}, active.Aliases())
+		assert.Equal(t, "", backing[:2][1], "slices held by readers should not be written to")
+	})
+
+	t.Run("concurrent readers and writers", func(t *testing.T) {
+		set := NewSyncEnumSet[*EnumBase]()
+		var wg sync.WaitGroup
+		for w := 0; w < 4; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < 50; i++ {
+					set.Register(NewEnumBase(w*100+i, fmt.Sprintf("E%d_%d", w, i), ""))
+					set.GetByName(fmt.Sprintf("E%d_%d", w, i/2))
+					set.GetByValue(i)
+				}
+			}(w)
+		}
+		wg.Wait()
+		assert.Len(t, set.Values(), 200)
+	})
+}
+
+// rwMutexEnumSet guards an EnumSet with a sync.RWMutex, the baseline for SyncEnumSet
+type rwMutexEnumSet struct {
+	mu  sync.RWMutex
+	set *EnumSet[*EnumBase]
+}
+
+func (s *rwMutexEnumSet) GetByName(name string) (*EnumBase, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.GetByName(name)
+}
+
+func BenchmarkConcurrentGetByName(b *testing.B) {
+	syncSet := NewSyncEnumSet[*EnumBase]()
+	locked := &rwMutexEnumSet{set: NewEnumSet[*EnumBase]()}
+	for i := 0; i < 64; i++ {
+		syncSet.Register(NewEnumBase(i, fmt.Sprintf("E%d", i), ""))
+		locked.set.Register(NewEnumBase(i, fmt.Sprintf("E%d", i), ""))
+	}
+
+	b.Run("SyncEnumSet", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				syncSet.GetByName("E42")
+			}
+		})
+	})
+	b.Run("RWMutex", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				locked.GetByName("E42")
+			}
+		})
+	})
+}
@@ -0,0 +1,218 @@
+package goenum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// configMarshaler is implemented by enums that can be marshaled with a per-call configuration
+type configMarshaler interface {
+	MarshalJSONWithConfig(config *EnumJSONConfig) ([]byte, error)
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// TaggedJSON wraps a value so that encoding/json marshals it with MarshalTagged:
+//
+//	json.NewEncoder(w).Encode(goenum.TaggedJSON{Value: response})
+type TaggedJSON struct {
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler
+func (t TaggedJSON) MarshalJSON() ([]byte, error) {
+	return MarshalTagged(t.Value)
+}
+
+// MarshalTagged marshals v like json.Marshal, except that enum fields (and slices of enums)
+// tagged `goenum:"format=value"` use that format, where format is name, value or full.
+// Structs, slices and arrays are walked honoring json tag names, "-" and omitempty;
+// maps and values implementing json.Marshaler are marshaled as usual.
+func MarshalTagged(v interface{}) ([]byte, error) {
+	return marshalTagged(reflect.ValueOf(v), nil)
+}
+
+// marshalTagged marshals v, using config for the enums it contains when non-nil
+func marshalTagged(v reflect.Value, config *EnumJSONConfig) ([]byte, error) {
+	if !v.IsValid() {
+		return []byte("null"), nil
+	}
+	if config != nil && v.CanInterface() {
+		if enum, ok := v.Interface().(configMarshaler); ok {
+			if v.Kind() == reflect.Ptr && v.IsNil() {
+				return []byte("null"), nil
+			}
+			return enum.MarshalJSONWithConfig(config)
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		if v.Type().Implements(jsonMarshalerType) {
+			return json.Marshal(v.Interface())
+		}
+		return marshalTagged(v.Elem(), config)
+	case reflect.Struct:
+		if v.Type().Implements(jsonMarshalerType) || reflect.PointerTo(v.Type()).Implements(jsonMarshalerType) {
+			return json.Marshal(v.Interface())
+		}
+		return marshalTaggedStruct(v)
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return json.Marshal(v.Interface())
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return []byte("null"), nil
+		}
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			data, err := marshalTagged(v.Index(i), config)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(data)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	default:
+		return json.Marshal(v.Interface())
+	}
+}
+
+// marshalTaggedStruct marshals the fields of a struct as a JSON object
+func marshalTaggedStruct(v reflect.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	if err := writeTaggedFields(&buf, v, &first); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// writeTaggedFields writes the fields of v, flattening embedded structs like encoding/json
+func writeTaggedFields(buf *bytes.Buffer, v reflect.Value, first *bool) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(jsonTag, ",")
+		value := v.Field(i)
+
+		if field.Anonymous && name == "" {
+			embedded := value
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct && !embedded.Type().Implements(jsonMarshalerType) &&
+				!reflect.PointerTo(embedded.Type()).Implements(jsonMarshalerType) {
+				if err := writeTaggedFields(buf, embedded, first); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if hasTagOption(opts, "omitempty") && isEmptyJSONValue(value) {
+			continue
+		}
+
+		var config *EnumJSONConfig
+		if tag, ok := field.Tag.Lookup("goenum"); ok {
+			parsed, err := parseEnumTag(tag)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			config = parsed
+		}
+		data, err := marshalTagged(value, config)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		if !*first {
+			buf.WriteByte(',')
+		}
+		*first = false
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+	return nil
+}
+
+// parseEnumTag parses a goenum struct tag such as "format=value"
+func parseEnumTag(tag string) (*EnumJSONConfig, error) {
+	config := DefaultJSONConfig()
+	for _, option := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+		switch key {
+		case "format":
+			switch value {
+			case "name":
+				config.Format = JSONFormatName
+			case "value":
+				config.Format = JSONFormatValue
+			case "full":
+				config.Format = JSONFormatFull
+			default:
+				return nil, fmt.Errorf("invalid goenum tag %q: unknown format %q", tag, value)
+			}
+		default:
+			return nil, fmt.Errorf("invalid goenum tag %q: unknown option %q", tag, key)
+		}
+	}
+	return config, nil
+}
+
+// hasTagOption reports whether a comma-separated tag option list contains option
+func hasTagOption(opts, option string) bool {
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyJSONValue reports whether v is empty in the sense of the omitempty option
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
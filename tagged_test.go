@@ -0,0 +1,113 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedAudit struct {
+	By string `json:"by"`
+}
+
+type taggedResponse struct {
+	taggedAudit
+	ID       int        `json:"id"`
+	Status   TestEnum   `json:"status"`
+	Code     TestEnum   `json:"code" goenum:"format=value"`
+	Detail   TestEnum   `json:"detail" goenum:"format=full"`
+	Previous *TestEnum  `json:"previous,omitempty" goenum:"format=value"`
+	History  []TestEnum `json:"history" goenum:"format=value"`
+	Ignored  string     `json:"-"`
+	internal int
+}
+
+func TestMarshalTagged(t *testing.T) {
+	t.Run("per-field formats", func(t *testing.T) {
+		resp := taggedResponse{
+			taggedAudit: taggedAudit{By: "admin"},
+			ID:          7,
+			Status:      TestEnumB,
+			Code:        TestEnumB,
+			Detail:      TestEnumA,
+			History:     []TestEnum{TestEnumA, TestEnumC},
+			Ignored:     "x",
+		}
+		data, err := MarshalTagged(resp)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"by": "admin",
+			"id": 7,
+			"status": "B",
+			"code": 2,
+			"detail": {"name": "A", "value": 1, "description": "First enum", "aliases": ["ALPHA"]},
+			"history": [1, 3]
+		}`, string(data), "tagged fields should use their own format")
+
+		plain, err := json.Marshal(resp)
+		assert.NoError(t, err)
+		assert.Contains(t, string(plain), `"code":"B"`, "json.Marshal should ignore goenum tags")
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		previous := TestEnumC
+		data, err := MarshalTagged(&taggedResponse{Previous: &previous})
+		assert.NoError(t, err)
+
+		var decoded map[string]interface{}
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, float64(3), decoded["previous"], "pointer enum fields should use the tag format")
+		assert.Nil(t, decoded["history"], "nil slices should marshal as null")
+	})
+
+	t.Run("TaggedJSON wrapper", func(t *testing.T) {
+		data, err := json.Marshal(map[string]interface{}{
+			"data": TaggedJSON{Value: struct {
+				Status TestEnum `json:"status" goenum:"format=value"`
+			}{TestEnumA}},
+		})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"data": {"status": 1}}`, string(data))
+	})
+
+	t.Run("nested structs", func(t *testing.T) {
+		type inner struct {
+			Status TestEnum `json:"status" goenum:"format=value"`
+		}
+		type outer struct {
+			Items []inner `json:"items"`
+			Inner inner   `json:"inner"`
+		}
+		data, err := MarshalTagged(outer{Items: []inner{{TestEnumA}}, Inner: inner{TestEnumB}})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"items": [{"status": 1}], "inner": {"status": 2}}`, string(data),
+			"tags should apply inside nested structs and slices")
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		_, err := MarshalTagged(struct {
+			Status TestEnum `goenum:"format=hex"`
+		}{TestEnumA})
+		assert.EqualError(t, err, `field Status: invalid goenum tag "format=hex": unknown format "hex"`)
+
+		_, err = MarshalTagged(struct {
+			Status TestEnum `goenum:"case=lower"`
+		}{TestEnumA})
+		assert.EqualError(t, err, `field Status: invalid goenum tag "case=lower": unknown option "case"`)
+	})
+
+	t.Run("non-struct values", func(t *testing.T) {
+		data, err := MarshalTagged([]int{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, `[1,2]`, string(data))
+
+		data, err = MarshalTagged([]byte("hi"))
+		assert.NoError(t, err)
+		assert.Equal(t, `"aGk="`, string(data), "byte slices should stay base64 encoded")
+
+		data, err = MarshalTagged(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `null`, string(data))
+	})
+}
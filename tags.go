@@ -0,0 +1,60 @@
+package goenum
+
+// taggedEnum is implemented by enums carrying tags
+type taggedEnum interface {
+	Tags() []string
+}
+
+// tagsOf returns the tags of an enum, or nil if it has none
+func tagsOf(enum Enum) []string {
+	if t, ok := enum.(taggedEnum); ok {
+		return t.Tags()
+	}
+	return nil
+}
+
+// WithTags adds tags such as "billing" or "beta" the enum does not have yet, classifying
+// it independently of its group, and returns the EnumBase for chaining
+func (e *EnumBase) WithTags(tags ...string) *EnumBase {
+	if e == nil {
+		return nil
+	}
+	for _, tag := range tags {
+		if !e.HasTag(tag) {
+			e.tags = append(e.tags, tag)
+		}
+	}
+	return e
+}
+
+// Tags returns the tags of the enum in the order they were added
+func (e *EnumBase) Tags() []string {
+	if e == nil {
+		return nil
+	}
+	return e.tags
+}
+
+// HasTag checks if the enum has the given tag
+func (e *EnumBase) HasTag(tag string) bool {
+	for _, t := range e.Tags() {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByTag returns the enums having the given tag, in registration order
+func (es *EnumSet[T]) FilterByTag(tag string) []T {
+	var result []T
+	for _, enum := range es.Values() {
+		for _, t := range tagsOf(enum) {
+			if t == tag {
+				result = append(result, enum)
+				break
+			}
+		}
+	}
+	return result
+}
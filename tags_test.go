@@ -0,0 +1,45 @@
+package goenum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags(t *testing.T) {
+	invoice := NewEnumBase(1, "INVOICE", "").WithTags("billing")
+	refund := NewEnumBase(2, "REFUND", "").WithTags("billing", "beta", "billing")
+	login := NewEnumBase(3, "LOGIN", "")
+	set := NewEnumSet[*EnumBase]().Register(invoice).Register(refund).Register(login)
+
+	t.Run("WithTags()", func(t *testing.T) {
+		assert.Equal(t, []string{"billing", "beta"}, refund.Tags(), "duplicate tags should be ignored")
+		assert.True(t, refund.HasTag("beta"))
+		assert.False(t, invoice.HasTag("beta"))
+		assert.Nil(t, login.Tags())
+		var nilEnum *EnumBase
+		assert.False(t, nilEnum.HasTag("billing"))
+	})
+
+	t.Run("FilterByTag()", func(t *testing.T) {
+		assert.Equal(t, []*EnumBase{invoice, refund}, set.FilterByTag("billing"))
+		assert.Equal(t, []*EnumBase{refund}, set.FilterByTag("beta"))
+		assert.Empty(t, set.FilterByTag("BILLING"))
+	})
+
+	t.Run("exports", func(t *testing.T) {
+		data, err := refund.MarshalJSONWithConfig(&EnumJSONConfig{Format: JSONFormatFull})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"REFUND","value":2,"description":"","tags":["billing","beta"]}`, string(data))
+		assert.Equal(t, []string{"billing", "beta"}, definitionOf(refund).Tags)
+
+		for _, intern := range []bool{false, true} {
+			options := DefaultValidationOptions()
+			options.Intern = intern
+			loader := NewDynamicEnumLoader(options)
+			assert.NoError(t, loader.LoadFromReader(strings.NewReader(`[{"name": "REFUND", "value": 2, "tags": ["billing", "beta"]}]`)))
+			assert.Len(t, loader.GetEnumSet().FilterByTag("beta"), 1)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package goenum
+
+import (
+	"fmt"
+)
+
+// TemplateFuncs returns functions for text/template and html/template that look enums up
+// in the named sets, or in the global registry when sets is nil:
+//
+//	{{ enumName "Status" .StatusValue }}  the name of the enum with a value
+//	{{ enumDesc "Status" .Status }}       the description of an enum, name or value
+//	{{ enumValue "Status" "ACTIVE" }}     the value of an enum, name or alias
+//	{{ range enumOptions "Status" }}      the enums of a set, in registration order
+//
+// The result can be passed to the Funcs method of either template package. Unknown sets
+// and enums stop the template with an error.
+func TemplateFuncs(sets map[string]AnyEnumSet) map[string]interface{} {
+	lookup := func(setName string) (AnyEnumSet, error) {
+		var set AnyEnumSet
+		var exists bool
+		if sets == nil {
+			set, exists = LookupSet(setName)
+		} else {
+			set, exists = sets[setName]
+		}
+		if !exists {
+			return nil, fmt.Errorf("enum set not found: %s", setName)
+		}
+		return set, nil
+	}
+	resolve := func(setName string, v interface{}) (Enum, error) {
+		set, err := lookup(setName)
+		if err != nil {
+			return nil, err
+		}
+		enum, unknown := resolveTemplateEnum(set, v)
+		if unknown != nil {
+			unknown.Set = setName
+			return nil, unknown
+		}
+		return enum, nil
+	}
+
+	return map[string]interface{}{
+		"enumName": func(setName string, value interface{}) (string, error) {
+			enum, err := resolve(setName, value)
+			if err != nil {
+				return "", err
+			}
+			return enum.String(), nil
+		},
+		"enumDesc": func(setName string, v interface{}) (string, error) {
+			enum, err := resolve(setName, v)
+			if err != nil {
+				return "", err
+			}
+			return enum.Description(), nil
+		},
+		"enumValue": func(setName string, v interface{}) (interface{}, error) {
+			enum, err := resolve(setName, v)
+			if err != nil {
+				return nil, err
+			}
+			return enum.Value(), nil
+		},
+		"enumOptions": func(setName string) ([]Enum, error) {
+			set, err := lookup(setName)
+			if err != nil {
+				return nil, err
+			}
+			names := set.Names()
+			options := make([]Enum, 0, len(names))
+			for _, name := range names {
+				if enum, exists := set.EnumByName(name); exists {
+					options = append(options, enum)
+				}
+			}
+			return options, nil
+		},
+	}
+}
+
+// resolveTemplateEnum finds v in set, as an enum, a value or, for strings, a name or alias
+func resolveTemplateEnum(set AnyEnumSet, v interface{}) (Enum, *UnknownEnumError) {
+	if enum, ok := v.(Enum); ok {
+		if registered, exists := set.EnumByName(enum.String()); exists {
+			return registered, nil
+		}
+		return nil, newUnknownEnumError(enum.String(), set)
+	}
+	if enum, exists := set.EnumByValue(v); exists {
+		return enum, nil
+	}
+	if name, ok := v.(string); ok {
+		if enum, exists := set.EnumByName(name); exists {
+			return enum, nil
+		}
+	}
+	return nil, newUnknownEnumError(fmt.Sprint(v), set)
+}
@@ -0,0 +1,52 @@
+package goenum
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	render := func(t *testing.T, funcs map[string]interface{}, text string, data interface{}) (string, error) {
+		t.Helper()
+		tmpl := template.Must(template.New("test").Funcs(funcs).Parse(text))
+		var b strings.Builder
+		err := tmpl.Execute(&b, data)
+		return b.String(), err
+	}
+	funcs := TemplateFuncs(map[string]AnyEnumSet{"Test": TestEnumSet})
+
+	t.Run("enumName, enumDesc and enumValue", func(t *testing.T) {
+		out, err := render(t, funcs, `{{ enumName "Test" .Value }} {{ enumDesc "Test" .Enum }} {{ enumDesc "Test" "beta" }} {{ enumValue "Test" "C" }}`,
+			map[string]interface{}{"Value": 1, "Enum": TestEnumB})
+		assert.NoError(t, err)
+		assert.Equal(t, "A Second enum Second enum 3", out)
+	})
+
+	t.Run("enumOptions", func(t *testing.T) {
+		out, err := render(t, funcs, `{{ range enumOptions "Test" }}{{ .Value }}={{ . }};{{ end }}`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "1=A;2=B;3=C;", out)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := render(t, funcs, `{{ enumName "Test" 9 }}`, nil)
+		assert.ErrorContains(t, err, `unknown enum "9" in Test`)
+		_, err = render(t, funcs, `{{ enumOptions "Missing" }}`, nil)
+		assert.ErrorContains(t, err, "enum set not found: Missing")
+	})
+
+	t.Run("global registry and html/template", func(t *testing.T) {
+		RegisterSet("TemplateTest", TestEnumSet)
+		t.Cleanup(func() { UnregisterSet("TemplateTest") })
+
+		tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(TemplateFuncs(nil)).Parse(
+			`{{ range enumOptions "TemplateTest" }}<option value="{{ .Value }}">{{ enumDesc "TemplateTest" . }}</option>{{ end }}`))
+		var b strings.Builder
+		assert.NoError(t, tmpl.Execute(&b, nil))
+		assert.Equal(t, `<option value="1">First enum</option><option value="2">Second enum</option><option value="3">Third enum</option>`, b.String())
+	})
+}
@@ -0,0 +1,45 @@
+package goenum
+
+import "fmt"
+
+// SetUnknown declares the sentinel enum (e.g. UNKNOWN) that set-aware and strict decoding
+// fall back to for unregistered names and values, instead of failing, so data from newer
+// producers still decodes. The sentinel marshals like any registered enum, so it decodes back
+// to itself rather than to a gap in the data, unless EnumJSONConfig.UnknownAsNull is set. It returns the EnumSet for chaining and panics
+// if the enum is not registered in the set, unless in panic-free mode.
+func (es *EnumSet[T]) SetUnknown(enum T) *EnumSet[T] {
+	if !es.Contains(enum) {
+		es.fail(fmt.Errorf("unknown sentinel %s is not registered", enum.String()))
+		return es
+	}
+	es.unknown = enum
+	es.hasUnknown = true
+	return es
+}
+
+// Unknown returns the unknown sentinel of the set, if one was declared
+func (es *EnumSet[T]) Unknown() (T, bool) {
+	return es.unknown, es.hasUnknown
+}
+
+// IsUnknown reports whether enum is the set's unknown sentinel
+func (es *EnumSet[T]) IsUnknown(enum T) bool {
+	return es.hasUnknown && enum.String() == es.unknown.String()
+}
+
+// ownerUnknown implements enumOwner
+func (es *EnumSet[T]) ownerUnknown() (Enum, bool) {
+	if !es.hasUnknown {
+		return nil, false
+	}
+	return es.unknown, true
+}
+
+// isUnknownSentinel reports whether the enum is the unknown sentinel of the set it is bound to
+func (e *EnumBase) isUnknownSentinel() bool {
+	if e.owner == nil {
+		return false
+	}
+	unknown, exists := e.owner.ownerUnknown()
+	return exists && unknown.String() == e.name
+}
@@ -0,0 +1,99 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownSentinel(t *testing.T) {
+	newSet := func() (*EnumSet[*EnumBase], *EnumBase, *EnumBase) {
+		unknown := NewEnumBase(0, "UNKNOWN", "Unrecognized value")
+		active := NewEnumBase(1, "ACTIVE", "Active")
+		set := NewEnumSet[*EnumBase]().Register(unknown).Register(active)
+		return set, unknown, active
+	}
+
+	t.Run("SetUnknown()", func(t *testing.T) {
+		set, unknown, active := newSet()
+		_, ok := set.Unknown()
+		assert.False(t, ok, "Unknown() should report no sentinel by default")
+		assert.False(t, set.IsUnknown(unknown), "IsUnknown() should be false without a sentinel")
+
+		assert.Same(t, set, set.SetUnknown(unknown), "SetUnknown() should return the set for chaining")
+		sentinel, ok := set.Unknown()
+		assert.True(t, ok)
+		assert.Same(t, unknown, sentinel)
+		assert.True(t, set.IsUnknown(unknown), "IsUnknown() should match the sentinel")
+		assert.False(t, set.IsUnknown(active), "IsUnknown() should not match other enums")
+
+		assert.PanicsWithValue(t, "unknown sentinel OTHER is not registered", func() {
+			set.SetUnknown(NewEnumBase(9, "OTHER", ""))
+		})
+	})
+
+	t.Run("ParseJSON() degrades to the sentinel", func(t *testing.T) {
+		set, unknown, active := newSet()
+		_, err := set.ParseJSON([]byte(`"ARCHIVED"`))
+		assert.ErrorIs(t, err, ErrUnknownEnum, "ParseJSON() should fail without a sentinel")
+
+		set.SetUnknown(unknown)
+		enum, err := set.ParseJSON([]byte(`"ARCHIVED"`))
+		assert.NoError(t, err)
+		assert.Same(t, unknown, enum, "ParseJSON() should return the sentinel for unknown names")
+
+		enum, err = set.ParseJSON([]byte(`"ACTIVE"`))
+		assert.NoError(t, err)
+		assert.Same(t, active, enum, "known names should still resolve")
+
+		_, err = set.ParseJSON([]byte(`{`))
+		assert.Error(t, err, "syntax errors should not degrade to the sentinel")
+	})
+
+	t.Run("strict unmarshal degrades to the sentinel", func(t *testing.T) {
+		set, unknown, active := newSet()
+		set.SetUnknown(unknown).SetJSONConfig(&EnumJSONConfig{Strict: true})
+
+		decoded := active.Clone()
+		assert.NoError(t, decoded.UnmarshalJSON([]byte(`"ARCHIVED"`)))
+		assert.Equal(t, "UNKNOWN", decoded.String(), "strict unmarshal should copy the sentinel")
+		assert.Equal(t, 0, decoded.Value())
+	})
+
+	t.Run("sentinel round-trips", func(t *testing.T) {
+		for _, format := range []JSONFormat{JSONFormatName, JSONFormatValue, JSONFormatFull} {
+			set, unknown, active := newSet()
+			set.SetUnknown(unknown).SetJSONConfig(&EnumJSONConfig{Format: format})
+
+			data, err := json.Marshal(unknown)
+			assert.NoError(t, err)
+			assert.NotEqual(t, "null", string(data), "the sentinel should not marshal as null")
+			enum, err := set.ParseJSON(data)
+			assert.NoError(t, err)
+			assert.Same(t, unknown, enum, "the sentinel should decode back to itself in format %v", format)
+
+			data, err = json.Marshal(active)
+			assert.NoError(t, err)
+			enum, err = set.ParseJSON(data)
+			assert.NoError(t, err)
+			assert.Same(t, active, enum)
+		}
+	})
+
+	t.Run("sentinel marshals as null when configured", func(t *testing.T) {
+		for _, format := range []JSONFormat{JSONFormatName, JSONFormatValue, JSONFormatFull} {
+			set, unknown, active := newSet()
+			set.SetUnknown(unknown).SetJSONConfig(&EnumJSONConfig{Format: format, UnknownAsNull: true})
+
+			data, err := json.Marshal(map[string]*EnumBase{"a": active, "u": unknown})
+			assert.NoError(t, err)
+			assert.Contains(t, string(data), `"u":null`, "the sentinel should marshal as null in format %v", format)
+			assert.NotContains(t, string(data), `"a":null`, "other enums should marshal normally")
+
+			enum, err := set.ParseJSON([]byte("null"))
+			assert.NoError(t, err)
+			assert.Same(t, unknown, enum, "null should decode back to the sentinel in format %v", format)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package goenum
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ParseJSON decodes data using the set's JSON configuration and resolves it to the
+// complete registered enum, restoring its value, description and aliases.
+// Unregistered names and values are rejected with an *UnknownEnumError,
+// or resolve to the unknown sentinel when one is set with SetUnknown.
+func (es *EnumSet[T]) ParseJSON(data []byte) (T, error) {
+	enum, err := es.parseJSON(data)
+	if err != nil && es.hasUnknown && errors.Is(err, ErrUnknownEnum) {
+		return es.unknown, nil
+	}
+	return enum, err
+}
+
+// parseJSON decodes data and resolves it to a registered enum
+func (es *EnumSet[T]) parseJSON(data []byte) (T, error) {
+	var zero T
+	config := es.JSONConfig()
+	if config.UnmarshalFunc != nil {
+		return es.parseJSONHook(data, config)
+	}
+
+	switch config.Format {
+	case JSONFormatValue:
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return zero, err
+		}
+		// Convert float64 to int if necessary
+		if f, ok := value.(float64); ok {
+			value = int(f)
+		}
+		enum, exists := es.resolveValue(value)
+		if !exists {
+			return zero, newUnknownEnumError(fmt.Sprint(value), es)
+		}
+		return enum, nil
+	case JSONFormatFull:
+		var full struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(data, &full); err != nil {
+			return zero, err
+		}
+		return es.parseJSONName(full.Name, config)
+	default: // JSONFormatName
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return zero, err
+		}
+		return es.parseJSONName(name, config)
+	}
+}
+
+// parseJSONName resolves a decoded name, undoing the configured name transform
+func (es *EnumSet[T]) parseJSONName(name string, config *EnumJSONConfig) (T, error) {
+	enum, exists := es.resolveName(config.NameTransform.normalize(name))
+	if !exists {
+		var zero T
+		return zero, newUnknownEnumError(name, es)
+	}
+	return enum, nil
+}
+
+// UnmarshalJSONInto resolves data to a registered enum and stores it in target; JSON null
+// leaves target unchanged. Use it to bind an enum type to its set:
+//
+//	func (s *Status) UnmarshalJSON(data []byte) error {
+//		return StatusEnumSet.UnmarshalJSONInto(data, s)
+//	}
+func (es *EnumSet[T]) UnmarshalJSONInto(data []byte, target *T) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	enum, err := es.ParseJSON(data)
+	if err != nil {
+		return err
+	}
+	*target = enum
+	return nil
+}
@@ -0,0 +1,88 @@
+package goenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setBoundEnum resolves itself against setBoundEnumSet when unmarshaled
+type setBoundEnum struct {
+	*EnumBase
+}
+
+var (
+	setBoundPending = setBoundEnum{NewEnumBase(0, "PENDING", "Waiting", "WAITING")}
+	setBoundActive  = setBoundEnum{NewEnumBase(1, "ACTIVE", "Running", "RUNNING")}
+)
+
+var setBoundEnumSet = NewEnumSet[setBoundEnum]().Register(setBoundPending).Register(setBoundActive)
+
+func (s *setBoundEnum) UnmarshalJSON(data []byte) error {
+	return setBoundEnumSet.UnmarshalJSONInto(data, s)
+}
+
+func TestSetAwareUnmarshal(t *testing.T) {
+	t.Run("resolves complete enum by name", func(t *testing.T) {
+		var decoded struct {
+			Status setBoundEnum `json:"status"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(`{"status":"ACTIVE"}`), &decoded))
+		assert.Equal(t, setBoundActive, decoded.Status, "UnmarshalJSONInto() should store the registered instance")
+		assert.Equal(t, 1, decoded.Status.Value(), "value should be restored")
+		assert.Equal(t, "Running", decoded.Status.Description(), "description should be restored")
+		assert.Equal(t, []string{"RUNNING"}, decoded.Status.Aliases(), "aliases should be restored")
+	})
+
+	t.Run("resolves aliases and casing", func(t *testing.T) {
+		var status setBoundEnum
+		assert.NoError(t, json.Unmarshal([]byte(`"waiting"`), &status))
+		assert.Equal(t, setBoundPending, status)
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		var status setBoundEnum
+		err := json.Unmarshal([]byte(`"DELETED"`), &status)
+		assert.EqualError(t, err, `unknown enum "DELETED" (allowed: PENDING, ACTIVE)`)
+		assert.Nil(t, status.EnumBase, "target should be untouched on error")
+	})
+
+	t.Run("null leaves target unchanged", func(t *testing.T) {
+		status := setBoundActive
+		assert.NoError(t, json.Unmarshal([]byte(`null`), &status))
+		assert.Equal(t, setBoundActive, status)
+	})
+
+	t.Run("value format", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA).Register(TestEnumB).
+			SetJSONConfig(&EnumJSONConfig{Format: JSONFormatValue})
+		enum, err := set.ParseJSON([]byte(`2`))
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumB, enum)
+
+		_, err = set.ParseJSON([]byte(`9`))
+		assert.EqualError(t, err, `unknown enum "9" (allowed: A, B)`)
+	})
+
+	t.Run("full format", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumC).
+			SetJSONConfig(&EnumJSONConfig{Format: JSONFormatFull})
+		enum, err := set.ParseJSON([]byte(`{"name":"C","value":0,"description":"stale"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, TestEnumC, enum, "registered data should win over the payload")
+	})
+
+	t.Run("name transform", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnum{NewEnumBase(5, "IN_PROGRESS", "Working")}).
+			SetJSONConfig(&EnumJSONConfig{NameTransform: JSONNameKebab})
+		enum, err := set.ParseJSON([]byte(`"in-progress"`))
+		assert.NoError(t, err)
+		assert.Equal(t, 5, enum.Value())
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := setBoundEnumSet.ParseJSON([]byte(`{`))
+		assert.Error(t, err)
+	})
+}
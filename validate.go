@@ -0,0 +1,153 @@
+package goenum
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ValidationReport is the outcome of a dry run of the loader
+type ValidationReport struct {
+	// Definitions is the number of definitions decoded
+	Definitions int
+	// Accepted is the number of definitions that would be registered
+	Accepted int
+	// Errors lists every rejected definition, in source order
+	Errors []*DefinitionError
+	// Warnings lists the non-fatal findings, as Warnings would after loading
+	Warnings []LoadWarning
+	// Violations lists the findings of the DocumentValidators of the options, which would
+	// stop the document from being loaded
+	Violations []*SchemaError
+}
+
+// Valid reports whether no definition was rejected and the document has no violations
+func (r *ValidationReport) Valid() bool {
+	return len(r.Errors) == 0 && len(r.Violations) == 0
+}
+
+// Err joins the document violations, as a *DocumentError, and the rejected definitions into
+// one error, or returns nil when the report is valid
+func (r *ValidationReport) Err() error {
+	errs := make([]error, 0, len(r.Errors)+1)
+	if len(r.Violations) > 0 {
+		errs = append(errs, &DocumentError{Violations: r.Violations})
+	}
+	for _, err := range r.Errors {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate runs the document validators and the full validation and duplicate analysis of
+// LoadFromReader against the enums loaded so far without registering anything, reporting
+// every violation and rejected definition instead of stopping at the first. The error is
+// only set when the input cannot be read or decoded.
+func (l *DynamicEnumLoader) Validate(reader io.Reader) (*ValidationReport, error) {
+	return l.validate(reader, ".json")
+}
+
+// validate runs Validate on a JSON document, passing format to the document validators
+func (l *DynamicEnumLoader) validate(reader io.Reader, format string) (*ValidationReport, error) {
+	reader, violations, err := l.documentViolations(format, l.jsonReader(reader))
+	if err != nil {
+		return nil, err
+	}
+	scratch := l.dryRun()
+	report := &ValidationReport{Violations: violations}
+	err = decodeDefinitions(reader, func(def EnumDefinition, position *DefinitionError) error {
+		report.Definitions++
+		if err := scratch.loadDefinition(def); err != nil {
+			position.Err = err
+			report.Errors = append(report.Errors, position)
+		}
+		return nil
+	})
+	if definitionErr, ok := err.(*DefinitionError); ok {
+		// A definition that cannot be decoded ends the array
+		report.Definitions++
+		report.Errors = append(report.Errors, definitionErr)
+	} else if err != nil {
+		return nil, err
+	}
+
+	report.Accepted = report.Definitions - len(report.Errors)
+	report.Warnings = scratch.warnings
+	return report, nil
+}
+
+// ValidateFile runs Validate on a file, choosing the decoder by extension like LoadFromFile
+func (l *DynamicEnumLoader) ValidateFile(filename string) (*ValidationReport, error) {
+	ext := filepath.Ext(filename)
+	var codec DefinitionCodec
+	if !strings.EqualFold(ext, ".json") {
+		var exists bool
+		if codec, exists = lookupLoaderFormat(ext); !exists {
+			return nil, fmt.Errorf("unsupported loader format: %q", ext)
+		}
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	var report *ValidationReport
+	if codec == nil {
+		report, err = l.validate(file, normalizeExtension(ext))
+	} else {
+		report, err = l.validateCodec(file, codec, normalizeExtension(ext))
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, definitionErr := range report.Errors {
+		definitionErr.File = filename
+	}
+	return report, nil
+}
+
+// validateCodec runs Validate on definitions of the given format decoded by codec
+func (l *DynamicEnumLoader) validateCodec(reader io.Reader, codec DefinitionCodec, format string) (*ValidationReport, error) {
+	reader, violations, err := l.documentViolations(format, reader)
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := codec.Decode(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode definitions: %w", err)
+	}
+
+	scratch := l.dryRun()
+	report := &ValidationReport{Definitions: len(definitions), Violations: violations}
+	for index, def := range definitions {
+		if err := scratch.loadDefinition(def); err != nil {
+			report.Errors = append(report.Errors, definitionErrorAt(index, def.Name, err))
+		}
+	}
+	report.Accepted = report.Definitions - len(report.Errors)
+	report.Warnings = scratch.warnings
+	return report, nil
+}
+
+// dryRun returns a loader with the options, migrations and a clone of the enum set of l,
+// so definitions can be loaded into it without affecting l
+func (l *DynamicEnumLoader) dryRun() *DynamicEnumLoader {
+	scratch := NewDynamicEnumLoader(l.options)
+	scratch.migrations = l.migrations
+	scratch.enumSet = l.enumSet.clone()
+	scratch.enumSet.aliasCollisionHook = nil
+	// The enums are shared with l, so moving aliases under DuplicateOverride must leave them intact
+	scratch.shared = true
+	for alias, name := range l.aliases {
+		if scratch.aliases == nil {
+			scratch.aliases = make(map[string]string)
+		}
+		scratch.aliases[alias] = name
+	}
+	return scratch
+}
@@ -0,0 +1,143 @@
+package goenum
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	const document = `[
+  {"name": "ACTIVE", "value": 1},
+  {"name": "", "value": 2},
+  {"name": "ACTIVE", "value": 3},
+  {"name": "PAUSED", "value": 4}
+]`
+
+	t.Run("reports every rejected definition", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		report, err := loader.Validate(strings.NewReader(document))
+		assert.NoError(t, err)
+		assert.False(t, report.Valid())
+		assert.Equal(t, 4, report.Definitions)
+		assert.Equal(t, 2, report.Accepted)
+		assert.Len(t, report.Errors, 2)
+		assert.EqualError(t, report.Errors[0], "line 3, column 3: definition #1: invalid enum definition: enum name cannot be empty")
+		assert.ErrorContains(t, report.Errors[1], "definition #2 (name=ACTIVE): duplicate enum found")
+		assert.ErrorContains(t, report.Err(), "duplicate enum found")
+		assert.Empty(t, loader.GetEnumSet().Values(), "Validate() should not register anything")
+	})
+
+	t.Run("checks against loaded enums", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{AliasHandling: DuplicateOverride})
+		assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{{Name: "PAUSED", Value: 4, Aliases: []string{"HELD"}}}))
+
+		report, err := loader.Validate(strings.NewReader(`[{"name": "HOLD", "value": 5, "aliases": ["HELD"]}, {"name": "PAUSED", "value": 6}]`))
+		assert.NoError(t, err)
+		assert.Len(t, report.Errors, 1)
+		assert.ErrorContains(t, report.Errors[0], "duplicate enum found: name=PAUSED")
+
+		paused, _ := loader.GetEnumSet().GetByName("PAUSED")
+		assert.Equal(t, []string{"HELD"}, paused.Aliases(), "Validate() should not move aliases of loaded enums")
+		assert.Len(t, loader.GetEnumSet().Values(), 1)
+	})
+
+	t.Run("checks against the set's policies", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{{Name: "PAUSED", Value: 4, Aliases: []string{"HELD"}}}))
+		set := loader.GetEnumSet().SetAliasCollisionPolicy(AliasCollisionError)
+
+		report, err := loader.Validate(strings.NewReader(`[{"name": "HELD", "value": 5}]`))
+		assert.NoError(t, err)
+		assert.Len(t, report.Errors, 1)
+		var collision *AliasCollision
+		assert.ErrorAs(t, report.Errors[0], &collision, "Validate() should use the alias index of the loaded set")
+		assert.Len(t, set.Values(), 1)
+	})
+
+	t.Run("valid input", func(t *testing.T) {
+		report, err := NewDynamicEnumLoader(nil).Validate(strings.NewReader(`[{"name": "A", "value": 1.5}]`))
+		assert.NoError(t, err)
+		assert.True(t, report.Valid())
+		assert.NoError(t, report.Err())
+		assert.Len(t, report.Warnings, 1)
+	})
+
+	t.Run("malformed input", func(t *testing.T) {
+		_, err := NewDynamicEnumLoader(nil).Validate(strings.NewReader(`{"name": "A"}`))
+		assert.Error(t, err)
+
+		report, err := NewDynamicEnumLoader(nil).Validate(strings.NewReader(`[{"name": "A", "value": 1}, {"name": 2}]`))
+		assert.NoError(t, err)
+		assert.ErrorContains(t, report.Err(), "definition #1: failed to decode JSON")
+	})
+
+	t.Run("ValidateFile", func(t *testing.T) {
+		RegisterLoaderFormat("props", propertiesCodec)
+		defer UnregisterLoaderFormat(".props")
+
+		dir := t.TempDir()
+		jsonFile := filepath.Join(dir, "statuses.json")
+		propsFile := filepath.Join(dir, "statuses.props")
+		assert.NoError(t, os.WriteFile(jsonFile, []byte(document), 0644))
+		assert.NoError(t, os.WriteFile(propsFile, []byte("ACTIVE=1\nACTIVE=2"), 0644))
+
+		loader := NewDynamicEnumLoader(nil)
+		report, err := loader.ValidateFile(jsonFile)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(report.Errors[0].Error(), jsonFile+": line 3"))
+
+		report, err = loader.ValidateFile(propsFile)
+		assert.NoError(t, err)
+		assert.EqualError(t, report.Err(), propsFile+": definition #1 (name=ACTIVE): duplicate enum found: name=ACTIVE, value=2")
+
+		_, err = loader.ValidateFile(filepath.Join(dir, "statuses.xml"))
+		assert.ErrorContains(t, err, "unsupported loader format")
+	})
+
+	t.Run("document validators", func(t *testing.T) {
+		RegisterLoaderFormat("props", propertiesCodec)
+		defer UnregisterLoaderFormat(".props")
+
+		dir := t.TempDir()
+		jsonFile := filepath.Join(dir, "statuses.json")
+		propsFile := filepath.Join(dir, "statuses.props")
+		assert.NoError(t, os.WriteFile(jsonFile, []byte(`[{"name":"A","value":1,"bogus":1}]`), 0644))
+		assert.NoError(t, os.WriteFile(propsFile, []byte("CODE_A=1"), 0644))
+
+		var formats []string
+		rejectCodes := DocumentValidatorFunc(func(format string, data []byte) error {
+			formats = append(formats, format)
+			if strings.Contains(string(data), "CODE_") {
+				return &SchemaError{Path: "$", Message: "codes are managed elsewhere"}
+			}
+			return nil
+		})
+		options := DefaultValidationOptions()
+		options.DocumentValidators = []DocumentValidator{BuiltinSchemaValidator, rejectCodes}
+		loader := NewDynamicEnumLoader(options)
+
+		report, err := loader.ValidateFile(jsonFile)
+		assert.NoError(t, err)
+		assert.False(t, report.Valid(), "ValidateFile() should run the document validators like LoadFromJSON()")
+		if assert.Len(t, report.Violations, 1) {
+			assert.Equal(t, "$[0].bogus", report.Violations[0].Path)
+		}
+		assert.Equal(t, 1, report.Accepted, "definitions should still be analyzed")
+		var documentErr *DocumentError
+		assert.ErrorAs(t, report.Err(), &documentErr)
+		assert.Error(t, loader.LoadFromJSON(jsonFile), "loading should fail on the same violation")
+
+		report, err = loader.ValidateFile(propsFile)
+		assert.NoError(t, err)
+		assert.EqualError(t, report.Err(), "definition document has 1 violation(s): $: codes are managed elsewhere")
+		assert.Equal(t, []string{".json", ".json", ".props"}, formats)
+
+		report, err = loader.Validate(strings.NewReader(`[{"name":"B","value":2}]`))
+		assert.NoError(t, err)
+		assert.True(t, report.Valid())
+	})
+}
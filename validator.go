@@ -0,0 +1,27 @@
+package goenum
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AddValidator adds a function that every enum must pass on registration
+// and returns the EnumSet for chaining
+func (es *EnumSet[T]) AddValidator(validator func(T) error) *EnumSet[T] {
+	es.validators = append(es.validators, validator)
+	return es
+}
+
+// runValidators runs all validators against the enum and joins their errors
+func (es *EnumSet[T]) runValidators(enum T) error {
+	var errs []error
+	for _, validator := range es.validators {
+		if err := validator(enum); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid enum %s: %w", enum.String(), errors.Join(errs...))
+}
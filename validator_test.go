@@ -0,0 +1,59 @@
+package goenum
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumSetValidators(t *testing.T) {
+	screamingSnake := regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+	errBadName := errors.New("name must be SCREAMING_SNAKE_CASE")
+	errNoDescription := errors.New("description must not be empty")
+
+	newValidatedSet := func() *EnumSet[TestEnum] {
+		return NewEnumSet[TestEnum]().
+			AddValidator(func(e TestEnum) error {
+				if !screamingSnake.MatchString(e.String()) {
+					return errBadName
+				}
+				return nil
+			}).
+			AddValidator(func(e TestEnum) error {
+				if e.Description() == "" {
+					return errNoDescription
+				}
+				return nil
+			})
+	}
+
+	t.Run("valid enum", func(t *testing.T) {
+		set := newValidatedSet()
+		assert.NoError(t, set.TryRegister(TestEnumA), "TryRegister() should accept valid enum")
+		assert.True(t, set.Contains(TestEnumA), "TryRegister() should register valid enum")
+	})
+
+	t.Run("aggregated errors", func(t *testing.T) {
+		set := newValidatedSet()
+		err := set.TryRegister(TestEnum{NewEnumBase(10, "badName", "")})
+		assert.Error(t, err, "TryRegister() should reject invalid enum")
+		assert.ErrorIs(t, err, errBadName, "TryRegister() should report name validator error")
+		assert.ErrorIs(t, err, errNoDescription, "TryRegister() should report description validator error")
+		assert.Empty(t, set.Values(), "TryRegister() should not register invalid enum")
+	})
+
+	t.Run("Register panics on validation failure", func(t *testing.T) {
+		set := newValidatedSet()
+		assert.Panics(t, func() {
+			set.Register(TestEnum{NewEnumBase(10, "VALID_NAME", "")})
+		}, "Register() should panic when a validator fails")
+	})
+
+	t.Run("TryRegister duplicates", func(t *testing.T) {
+		set := NewEnumSet[TestEnum]().Register(TestEnumA)
+		assert.EqualError(t, set.TryRegister(TestEnum{NewEnumBase(99, "A", "Duplicate name")}), "duplicate enum name: A")
+		assert.EqualError(t, set.TryRegister(TestEnum{NewEnumBase(1, "Z", "Duplicate value")}), "duplicate enum value: 1")
+	})
+}
@@ -0,0 +1,140 @@
+package goenum
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ValueAs returns the value of enum as V, e.g. goenum.ValueAs[int](status). Numeric values
+// are converted between integer and floating-point types when they fit without loss, so a
+// float64 decoded from JSON reads as an int; values of string kinds convert to V of a string
+// kind. Any other mismatch is returned as an error instead of panicking like a type assertion.
+func ValueAs[V any](enum Enum) (V, error) {
+	var zero V
+	if enum == nil {
+		return zero, fmt.Errorf("cannot read the value of a nil enum")
+	}
+	value := enum.Value()
+	if v, ok := value.(V); ok {
+		return v, nil
+	}
+
+	target := reflect.TypeOf((*V)(nil)).Elem()
+	if converted, ok := convertValue(reflect.ValueOf(value), target); ok {
+		return converted.Interface().(V), nil
+	}
+	return zero, fmt.Errorf("value %v (%T) of enum %s cannot be converted to %s", value, value, enum.String(), target)
+}
+
+// Int returns the value as an int, converting it like ValueAs
+func (e *EnumBase) Int() (int, error) {
+	return ValueAs[int](e)
+}
+
+// Int64 returns the value as an int64, converting it like ValueAs
+func (e *EnumBase) Int64() (int64, error) {
+	return ValueAs[int64](e)
+}
+
+// StringValue returns the value as a string, converting it like ValueAs.
+// Unlike String, which returns the name, it fails for values that are not strings.
+func (e *EnumBase) StringValue() (string, error) {
+	return ValueAs[string](e)
+}
+
+// convertValue converts a numeric or string value to target without loss of information
+func convertValue(v reflect.Value, target reflect.Type) (reflect.Value, bool) {
+	if !v.IsValid() {
+		return reflect.Value{}, false
+	}
+	result := reflect.New(target).Elem()
+
+	switch v.Kind() {
+	case reflect.String:
+		if target.Kind() == reflect.String {
+			result.SetString(v.String())
+			return result, true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return result, setInt(result, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := v.Uint()
+		if isIntKind(target.Kind()) {
+			return result, u <= math.MaxInt64 && setInt(result, int64(u))
+		}
+		return result, setUint(result, u)
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if isFloatKind(target.Kind()) {
+			if result.OverflowFloat(f) {
+				return result, false
+			}
+			result.SetFloat(f)
+			return result, true
+		}
+		// Integers only, within the range of int64 or uint64
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxUint64 {
+			return result, false
+		}
+		if f < math.MaxInt64 {
+			return result, setInt(result, int64(f))
+		}
+		return result, setUint(result, uint64(f))
+	}
+	return result, false
+}
+
+// setInt stores i in an integer, unsigned integer or floating-point result if it fits
+func setInt(result reflect.Value, i int64) bool {
+	switch {
+	case isIntKind(result.Kind()):
+		if result.OverflowInt(i) {
+			return false
+		}
+		result.SetInt(i)
+	case isUintKind(result.Kind()):
+		if i < 0 || result.OverflowUint(uint64(i)) {
+			return false
+		}
+		result.SetUint(uint64(i))
+	case isFloatKind(result.Kind()):
+		result.SetFloat(float64(i))
+	default:
+		return false
+	}
+	return true
+}
+
+// setUint stores u in an unsigned integer or floating-point result if it fits
+func setUint(result reflect.Value, u uint64) bool {
+	switch {
+	case isUintKind(result.Kind()):
+		if result.OverflowUint(u) {
+			return false
+		}
+		result.SetUint(u)
+	case isIntKind(result.Kind()):
+		return u <= math.MaxInt64 && setInt(result, int64(u))
+	case isFloatKind(result.Kind()):
+		result.SetFloat(float64(u))
+	default:
+		return false
+	}
+	return true
+}
+
+// isIntKind reports whether k is a signed integer kind
+func isIntKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+// isUintKind reports whether k is an unsigned integer kind
+func isUintKind(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+// isFloatKind reports whether k is a floating-point kind
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
@@ -0,0 +1,78 @@
+package goenum
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type colorName string
+
+func TestValueAs(t *testing.T) {
+	t.Run("exact types", func(t *testing.T) {
+		value, err := ValueAs[int](TestEnumA)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+
+		name, err := ValueAs[colorName](NewEnumBase(colorName("red"), "RED", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, colorName("red"), name)
+	})
+
+	t.Run("numeric conversions", func(t *testing.T) {
+		fromJSON := NewEnumBase(float64(42), "ANSWER", "")
+		i, err := fromJSON.Int()
+		assert.NoError(t, err)
+		assert.Equal(t, 42, i)
+		i64, err := fromJSON.Int64()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), i64)
+
+		u8, err := ValueAs[uint8](NewEnumBase(200, "B", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(200), u8)
+
+		f, err := ValueAs[float64](NewEnumBase(int32(-7), "C", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, float64(-7), f)
+
+		big, err := ValueAs[uint64](NewEnumBase(float64(1<<63), "D", ""))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1<<63), big)
+	})
+
+	t.Run("lossy conversions fail", func(t *testing.T) {
+		_, err := NewEnumBase(2.5, "HALF", "").Int()
+		assert.EqualError(t, err, "value 2.5 (float64) of enum HALF cannot be converted to int")
+
+		for _, enum := range []*EnumBase{
+			NewEnumBase(300, "OVERFLOW", ""),
+			NewEnumBase(-1, "NEGATIVE", ""),
+			NewEnumBase(math.Inf(1), "INFINITE", ""),
+			NewEnumBase("1", "TEXT", ""),
+		} {
+			_, err := ValueAs[uint8](enum)
+			assert.Error(t, err, enum.String())
+		}
+		_, err = ValueAs[int64](NewEnumBase(uint64(math.MaxUint64), "MAX", ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		value, err := NewEnumBase(colorName("red"), "RED", "").StringValue()
+		assert.NoError(t, err)
+		assert.Equal(t, "red", value)
+
+		_, err = TestEnumA.StringValue()
+		assert.EqualError(t, err, "value 1 (int) of enum A cannot be converted to string")
+	})
+
+	t.Run("nil enums", func(t *testing.T) {
+		_, err := ValueAs[int](nil)
+		assert.Error(t, err)
+		var nilEnum *EnumBase
+		_, err = nilEnum.Int()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,56 @@
+package goenum
+
+import "fmt"
+
+// LoadWarningKind classifies a LoadWarning
+type LoadWarningKind int
+
+const (
+	// WarningDuplicateSkipped reports a definition skipped as a duplicate under DuplicateSkip
+	WarningDuplicateSkipped LoadWarningKind = iota
+	// WarningAliasSkipped reports an alias dropped as a duplicate under AliasHandling DuplicateSkip
+	WarningAliasSkipped
+	// WarningValueCoerced reports a fractional JSON number or float64 value truncated to an int value
+	WarningValueCoerced
+	// WarningEmptyAlias reports an empty or blank alias, which GetByName cannot resolve usefully
+	WarningEmptyAlias
+)
+
+// String returns the name of the warning kind
+func (k LoadWarningKind) String() string {
+	switch k {
+	case WarningDuplicateSkipped:
+		return "duplicate skipped"
+	case WarningAliasSkipped:
+		return "alias skipped"
+	case WarningValueCoerced:
+		return "value coerced"
+	case WarningEmptyAlias:
+		return "empty alias"
+	}
+	return fmt.Sprintf("LoadWarningKind(%d)", int(k))
+}
+
+// LoadWarning reports something the loader did to a definition without failing
+type LoadWarning struct {
+	Kind LoadWarningKind
+	// Name is the name of the definition
+	Name string
+	// Message describes what the loader did
+	Message string
+}
+
+// String returns a readable form of the warning, e.g. "ACTIVE: duplicate skipped: ..."
+func (w LoadWarning) String() string {
+	return fmt.Sprintf("%s: %s: %s", w.Name, w.Kind, w.Message)
+}
+
+// Warnings returns the non-fatal findings of every load so far, in the order they occurred
+func (l *DynamicEnumLoader) Warnings() []LoadWarning {
+	return append([]LoadWarning(nil), l.warnings...)
+}
+
+// warn records a LoadWarning for the definition name
+func (l *DynamicEnumLoader) warn(kind LoadWarningKind, name string, format string, args ...interface{}) {
+	l.warnings = append(l.warnings, LoadWarning{Kind: kind, Name: name, Message: fmt.Sprintf(format, args...)})
+}
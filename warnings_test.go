@@ -0,0 +1,51 @@
+package goenum
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoaderWarnings(t *testing.T) {
+	t.Run("collects non-fatal findings", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip, AliasHandling: DuplicateSkip})
+		err := loader.LoadFromReader(strings.NewReader(`[
+			{"name": "ACTIVE", "value": 1, "aliases": ["RUNNING"]},
+			{"name": "ACTIVE", "value": 2},
+			{"name": "PAUSED", "value": 2.5, "aliases": ["running", " "]}
+		]`))
+		assert.NoError(t, err)
+
+		warnings := loader.Warnings()
+		kinds := make([]LoadWarningKind, 0, len(warnings))
+		for _, warning := range warnings {
+			kinds = append(kinds, warning.Kind)
+		}
+		assert.Equal(t, []LoadWarningKind{WarningDuplicateSkipped, WarningValueCoerced, WarningAliasSkipped, WarningEmptyAlias}, kinds)
+		assert.Equal(t, "ACTIVE: duplicate skipped: duplicate of ACTIVE (value=1) skipped", warnings[0].String())
+		assert.Equal(t, "PAUSED: value coerced: value 2.5 truncated to 2", warnings[1].String())
+	})
+
+	t.Run("integral values are not reported", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromReader(strings.NewReader(`[{"name": "A", "value": 1}, {"name": "B", "value": 2}]`)))
+		assert.Empty(t, loader.Warnings())
+	})
+
+	t.Run("in-memory definitions", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(nil)
+		assert.NoError(t, loader.LoadFromMap(map[string]EnumDefinition{"paused": {Name: "PAUSED", Value: 2.5}}))
+		enum, _ := loader.GetEnumSet().GetByName("PAUSED")
+		assert.Equal(t, 2, enum.Value(), "LoadFromMap() should coerce values like LoadFromReader()")
+		assert.Equal(t, []LoadWarning{{Kind: WarningValueCoerced, Name: "PAUSED", Message: "value 2.5 truncated to 2"}}, loader.Warnings())
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		loader := NewDynamicEnumLoader(&ValidationOptions{DuplicateHandling: DuplicateSkip})
+		assert.NoError(t, loader.LoadFromSlice([]EnumDefinition{{Name: "A", Value: 1}, {Name: "B", Value: 1}}))
+		warnings := loader.Warnings()
+		warnings[0].Name = "changed"
+		assert.Equal(t, "B", loader.Warnings()[0].Name)
+	})
+}